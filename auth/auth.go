@@ -0,0 +1,166 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/celestiaorg/celestia-node/libs/keystore"
+)
+
+// SecretKeyName is the name under which the secret used to sign tokens is
+// stored in the keystore of the node store
+const SecretKeyName keystore.KeyName = "dalc-jwt-secret"
+
+const secretSize = 32
+
+// Permission allows calling a group of methods
+type Permission string
+
+const (
+	// PermRead allows retrieving blocks and checking their availability
+	PermRead Permission = "read"
+	// PermSubmit allows submitting blocks, which spends the funds of the
+	// signer
+	PermSubmit Permission = "submit"
+	// PermAdmin allows calling administrative methods
+	PermAdmin Permission = "admin"
+)
+
+var (
+	// ErrInvalidToken is returned when a token is malformed or its signature
+	// does not match
+	ErrInvalidToken = errors.New("auth: invalid token")
+	// ErrExpiredToken is returned when a token is used after it expired
+	ErrExpiredToken = errors.New("auth: token expired")
+)
+
+// PermissionsForLevel returns the permissions granted to a token of the
+// provided level. Each level includes the permissions of the previous one:
+// read, submit and admin.
+func PermissionsForLevel(level Permission) ([]Permission, error) {
+	switch level {
+	case PermRead:
+		return []Permission{PermRead}, nil
+	case PermSubmit:
+		return []Permission{PermRead, PermSubmit}, nil
+	case PermAdmin:
+		return []Permission{PermRead, PermSubmit, PermAdmin}, nil
+	default:
+		return nil, fmt.Errorf("auth: unknown permission level %q", level)
+	}
+}
+
+// Claims are the contents of a token
+type Claims struct {
+	Permissions []Permission `json:"perms"`
+	IssuedAt    int64        `json:"iat"`
+	// ExpiresAt is the unix time after which the token is rejected. Zero
+	// means the token never expires
+	ExpiresAt int64 `json:"exp,omitempty"`
+}
+
+// Allows returns true if the claims grant the provided permission
+func (c Claims) Allows(perm Permission) bool {
+	for _, p := range c.Permissions {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
+
+// header is the fixed JWT header of the tokens, which are signed with
+// HMAC-SHA256
+var header = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+// NewToken creates a JWT granting the provided permissions signed with secret.
+// A zero ttl creates a token that never expires, negative ttls are rejected.
+func NewToken(secret []byte, perms []Permission, ttl time.Duration) (string, error) {
+	if ttl < 0 {
+		return "", fmt.Errorf("auth: negative token ttl %v", ttl)
+	}
+	now := time.Now()
+	claims := Claims{
+		Permissions: perms,
+		IssuedAt:    now.Unix(),
+	}
+	if ttl > 0 {
+		claims.ExpiresAt = now.Add(ttl).Unix()
+	}
+
+	rawClaims, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	payload := header + "." + base64.RawURLEncoding.EncodeToString(rawClaims)
+	return payload + "." + base64.RawURLEncoding.EncodeToString(sign(secret, payload)), nil
+}
+
+// VerifyToken checks the signature and expiry of a token and returns its
+// claims
+func VerifyToken(secret []byte, token string) (Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != header {
+		return Claims{}, ErrInvalidToken
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return Claims{}, ErrInvalidToken
+	}
+	if !hmac.Equal(sig, sign(secret, parts[0]+"."+parts[1])) {
+		return Claims{}, ErrInvalidToken
+	}
+
+	rawClaims, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return Claims{}, ErrInvalidToken
+	}
+	var claims Claims
+	err = json.Unmarshal(rawClaims, &claims)
+	if err != nil {
+		return Claims{}, ErrInvalidToken
+	}
+
+	if claims.ExpiresAt != 0 && time.Now().Unix() > claims.ExpiresAt {
+		return Claims{}, ErrExpiredToken
+	}
+	return claims, nil
+}
+
+func sign(secret []byte, payload string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(payload)) //nolint:errcheck
+	return mac.Sum(nil)
+}
+
+// LoadOrGenerateSecret returns the secret used to sign tokens from the
+// keystore, generating and storing a new one if none exists yet
+func LoadOrGenerateSecret(ks keystore.Keystore) ([]byte, error) {
+	key, err := ks.Get(SecretKeyName)
+	switch {
+	case err == nil:
+		return key.Body, nil
+	case !errors.Is(err, keystore.ErrNotFound):
+		return nil, err
+	}
+
+	secret := make([]byte, secretSize)
+	_, err = rand.Read(secret)
+	if err != nil {
+		return nil, err
+	}
+	err = ks.Put(SecretKeyName, keystore.PrivKey{Body: secret})
+	if err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-node/libs/keystore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestToken(t *testing.T) {
+	secret := []byte("secret")
+	perms, err := PermissionsForLevel(PermSubmit)
+	require.NoError(t, err)
+
+	token, err := NewToken(secret, perms, 0)
+	require.NoError(t, err)
+
+	claims, err := VerifyToken(secret, token)
+	require.NoError(t, err)
+	assert.True(t, claims.Allows(PermRead))
+	assert.True(t, claims.Allows(PermSubmit))
+	assert.False(t, claims.Allows(PermAdmin))
+
+	_, err = VerifyToken([]byte("other secret"), token)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	_, err = VerifyToken(secret, token[:len(token)-2])
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	_, err = NewToken(secret, perms, -time.Minute)
+	assert.Error(t, err, "negative ttls are rejected")
+
+	expiring, err := NewToken(secret, perms, time.Hour)
+	require.NoError(t, err)
+	claims, err = VerifyToken(secret, expiring)
+	require.NoError(t, err)
+	assert.Equal(t, claims.IssuedAt+int64(time.Hour/time.Second), claims.ExpiresAt)
+}
+
+func TestAuthorize(t *testing.T) {
+	secret := []byte("secret")
+	readToken, err := NewToken(secret, []Permission{PermRead}, 0)
+	require.NoError(t, err)
+
+	type test struct {
+		name   string
+		md     metadata.MD
+		method string
+		code   codes.Code
+	}
+	tests := []test{
+		{"missing token", metadata.MD{}, "/dalc.DALCService/RetrieveBlocks", codes.Unauthenticated},
+		{"invalid token", metadata.Pairs("authorization", "Bearer nope"), "/dalc.DALCService/RetrieveBlocks", codes.Unauthenticated},
+		{"read allowed", metadata.Pairs("authorization", "Bearer "+readToken), "/dalc.DALCService/RetrieveBlocks", codes.OK},
+		{"submit denied", metadata.Pairs("authorization", "bearer "+readToken), "/dalc.DALCService/SubmitBlock", codes.PermissionDenied},
+		{"unknown method requires admin", metadata.Pairs("authorization", "Bearer "+readToken), "/dalc.ChaosService/SetFaults", codes.PermissionDenied},
+		{"health is public", metadata.MD{}, "/grpc.health.v1.Health/Check", codes.OK},
+	}
+
+	for _, tt := range tests {
+		ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+		err := Authorize(ctx, secret, tt.method)
+		assert.Equal(t, tt.code, status.Code(err), tt.name)
+	}
+}
+
+func TestLoadOrGenerateSecret(t *testing.T) {
+	ks, err := keystore.NewFSKeystore(t.TempDir() + "/keys")
+	require.NoError(t, err)
+
+	secret, err := LoadOrGenerateSecret(ks)
+	require.NoError(t, err)
+	assert.Len(t, secret, secretSize)
+
+	loaded, err := LoadOrGenerateSecret(ks)
+	require.NoError(t, err)
+	assert.Equal(t, secret, loaded)
+}
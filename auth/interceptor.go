@@ -0,0 +1,121 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// MethodPermissions maps the full grpc method names to the permission
+// required to call them. Methods that are not listed require PermAdmin.
+var MethodPermissions = map[string]Permission{
+	"/dalc.DALCService/RetrieveBlocks":         PermRead,
+	"/dalc.DALCService/CheckBlockAvailability": PermRead,
+	"/dalc.DALCService/GetInfo":                PermRead,
+	"/dalc.DALCService/SubmitBlock":            PermSubmit,
+}
+
+// PublicMethods can be called without a token, so that load balancers and
+// orchestrators can probe the dalc
+var PublicMethods = map[string]bool{
+	"/grpc.health.v1.Health/Check": true,
+	"/grpc.health.v1.Health/Watch": true,
+}
+
+// RequiredPermission returns the permission required to call the provided
+// full grpc method name
+func RequiredPermission(method string) Permission {
+	perm, has := MethodPermissions[method]
+	if !has {
+		return PermAdmin
+	}
+	return perm
+}
+
+// UnaryServerInterceptor rejects requests that do not carry a bearer token
+// signed with secret which grants the permission required by the method
+func UnaryServerInterceptor(secret []byte) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		err := Authorize(ctx, secret, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor rejects streams that do not carry a bearer token
+// signed with secret which grants the permission required by the method
+func StreamServerInterceptor(secret []byte) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := Authorize(ss.Context(), secret, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// Authorize returns a grpc status error if the bearer token in the incoming
+// metadata of ctx does not grant the permission required by method
+func Authorize(ctx context.Context, secret []byte, method string) error {
+	if PublicMethods[method] {
+		return nil
+	}
+
+	token, err := bearerToken(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	claims, err := VerifyToken(secret, token)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	perm := RequiredPermission(method)
+	if !claims.Allows(perm) {
+		return status.Errorf(codes.PermissionDenied, "auth: %s requires the %s permission", method, perm)
+	}
+	return nil
+}
+
+func bearerToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", errors.New("auth: missing authorization metadata")
+	}
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", errors.New("auth: missing authorization metadata")
+	}
+
+	const prefix = "bearer "
+	if len(values[0]) <= len(prefix) || !strings.EqualFold(values[0][:len(prefix)], prefix) {
+		return "", errors.New("auth: authorization metadata is not a bearer token")
+	}
+	return strings.TrimSpace(values[0][len(prefix):]), nil
+}
+
+// TokenCredentials attaches a bearer token to the requests of a grpc client
+type TokenCredentials struct {
+	Token string
+	// AllowInsecure sends the token over connections without transport
+	// security
+	AllowInsecure bool
+}
+
+// GetRequestMetadata implements credentials.PerRPCCredentials
+func (tc TokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + tc.Token}, nil
+}
+
+// RequireTransportSecurity implements credentials.PerRPCCredentials
+func (tc TokenCredentials) RequireTransportSecurity() bool {
+	return !tc.AllowInsecure
+}
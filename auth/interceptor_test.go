@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+)
+
+func TestStreamServerInterceptor(t *testing.T) {
+	ctx := context.Background()
+	secret := []byte("secret")
+	srv := grpc.NewServer(grpc.StreamInterceptor(StreamServerInterceptor(secret)))
+	healthpb.RegisterHealthServer(srv, health.NewServer())
+	reflection.Register(srv)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go srv.Serve(lis) //nolint:errcheck
+	t.Cleanup(srv.Stop)
+
+	dial := func(token string) *grpc.ClientConn {
+		opts := []grpc.DialOption{grpc.WithInsecure()}
+		if token != "" {
+			opts = append(opts, grpc.WithPerRPCCredentials(TokenCredentials{Token: token, AllowInsecure: true}))
+		}
+		conn, err := grpc.Dial(lis.Addr().String(), opts...)
+		require.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+		return conn
+	}
+	listServices := func(conn *grpc.ClientConn) error {
+		stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+		require.NoError(t, err)
+		err = stream.Send(&reflectionpb.ServerReflectionRequest{
+			MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+		})
+		require.NoError(t, err)
+		_, err = stream.Recv()
+		return err
+	}
+
+	err = listServices(dial(""))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	readToken, err := NewToken(secret, []Permission{PermRead}, 0)
+	require.NoError(t, err)
+	err = listServices(dial(readToken))
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	adminToken, err := NewToken(secret, []Permission{PermAdmin}, 0)
+	require.NoError(t, err)
+	assert.NoError(t, listServices(dial(adminToken)))
+
+	// health is public, streams included
+	watch, err := healthpb.NewHealthClient(dial("")).Watch(ctx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	resp, err := watch.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+}
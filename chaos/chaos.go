@@ -0,0 +1,250 @@
+package chaos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	logging "github.com/ipfs/go-log/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/celestiaorg/dalc/config"
+	"github.com/celestiaorg/dalc/proto/dalc"
+	"github.com/celestiaorg/dalc/proto/optimint"
+)
+
+var log = logging.Logger("dalc/chaos")
+
+var (
+	// ErrInjected is returned by requests failed on purpose
+	ErrInjected = errors.New("chaos: injected error")
+	// ErrInjectedTimeout is returned by requests timed out on purpose
+	ErrInjectedTimeout = errors.New("chaos: injected timeout")
+)
+
+// LightClient wraps a DALCService implementation and makes it misbehave
+// according to the configured faults. It also implements the ChaosService,
+// which allows changing the faults at runtime.
+type LightClient struct {
+	next dalc.DALCServiceServer
+
+	mtx    sync.RWMutex
+	faults config.ChaosConfig
+
+	randMtx sync.Mutex
+	rand    *rand.Rand
+}
+
+// New wraps the provided DALCService implementation with the faults described
+// by cfg
+func New(next dalc.DALCServiceServer, cfg config.ChaosConfig) (*LightClient, error) {
+	err := validate(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &LightClient{
+		next:   next,
+		faults: cfg,
+		rand:   rand.New(rand.NewSource(time.Now().UnixNano())), //nolint:gosec
+	}, nil
+}
+
+// SubmitBlock forwards the block to the wrapped DALCService unless it fails or
+// drops the submission on purpose
+func (lc *LightClient) SubmitBlock(ctx context.Context, req *dalc.SubmitBlockRequest) (*dalc.SubmitBlockResponse, error) {
+	faults := lc.currentFaults()
+	result, err := lc.injectFailure(ctx, faults)
+	if result != nil {
+		return &dalc.SubmitBlockResponse{Result: result}, err
+	}
+
+	if lc.roll(faults.DropRate) {
+		log.Debugw("dropping submitted block", "height", req.Block.GetHeader().GetHeight())
+		return &dalc.SubmitBlockResponse{Result: &dalc.DAResponse{Code: dalc.StatusCode_STATUS_CODE_SUCCESS}}, nil
+	}
+
+	return lc.next.SubmitBlock(ctx, req)
+}
+
+// CheckBlockAvailability forwards the request to the wrapped DALCService and
+// possibly reports the opposite result
+func (lc *LightClient) CheckBlockAvailability(ctx context.Context, req *dalc.CheckBlockAvailabilityRequest) (*dalc.CheckBlockAvailabilityResponse, error) {
+	faults := lc.currentFaults()
+	result, err := lc.injectFailure(ctx, faults)
+	if result != nil {
+		return &dalc.CheckBlockAvailabilityResponse{Result: result}, err
+	}
+
+	resp, err := lc.next.CheckBlockAvailability(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+
+	if lc.roll(faults.FalseAvailabilityRate) {
+		log.Debugw("flipping data availability", "height", req.DataLayerHeight)
+		resp.DataAvailable = !resp.DataAvailable
+	}
+	return resp, nil
+}
+
+// RetrieveBlocks forwards the request to the wrapped DALCService and possibly
+// drops, duplicates or reorders the returned blocks
+func (lc *LightClient) RetrieveBlocks(ctx context.Context, req *dalc.RetrieveBlocksRequest) (*dalc.RetrieveBlocksResponse, error) {
+	faults := lc.currentFaults()
+	result, err := lc.injectFailure(ctx, faults)
+	if result != nil {
+		return &dalc.RetrieveBlocksResponse{Result: result}, err
+	}
+
+	resp, err := lc.next.RetrieveBlocks(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+
+	blocks := make([]*optimint.Block, 0, len(resp.Blocks))
+	for _, block := range resp.Blocks {
+		if lc.roll(faults.DropRate) {
+			continue
+		}
+		blocks = append(blocks, block)
+		if lc.roll(faults.DuplicateRate) {
+			blocks = append(blocks, block)
+		}
+	}
+	if lc.roll(faults.ReorderRate) {
+		lc.randMtx.Lock()
+		lc.rand.Shuffle(len(blocks), func(i, j int) {
+			blocks[i], blocks[j] = blocks[j], blocks[i]
+		})
+		lc.randMtx.Unlock()
+	}
+	resp.Blocks = blocks
+
+	return resp, nil
+}
+
+// GetInfo forwards the request to the wrapped DALCService without injecting
+// faults, so that the setup of the dalc can always be inspected
+func (lc *LightClient) GetInfo(ctx context.Context, req *dalc.GetInfoRequest) (*dalc.GetInfoResponse, error) {
+	return lc.next.GetInfo(ctx, req)
+}
+
+// GetFaults returns the currently injected faults
+func (lc *LightClient) GetFaults(ctx context.Context, req *dalc.GetFaultsRequest) (*dalc.GetFaultsResponse, error) {
+	return &dalc.GetFaultsResponse{Faults: toFaults(lc.currentFaults())}, nil
+}
+
+// SetFaults replaces the injected faults
+func (lc *LightClient) SetFaults(ctx context.Context, req *dalc.SetFaultsRequest) (*dalc.SetFaultsResponse, error) {
+	lc.mtx.Lock()
+	defer lc.mtx.Unlock()
+
+	faults := fromFaults(req.Faults)
+	faults.Enabled = lc.faults.Enabled
+	err := validate(faults)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Infow("changing injected faults", "faults", req.Faults.String())
+	lc.faults = faults
+	return &dalc.SetFaultsResponse{Faults: toFaults(faults)}, nil
+}
+
+func (lc *LightClient) currentFaults() config.ChaosConfig {
+	lc.mtx.RLock()
+	defer lc.mtx.RUnlock()
+	return lc.faults
+}
+
+// injectFailure delays the request and returns the result of a request failed
+// on purpose, if any
+func (lc *LightClient) injectFailure(ctx context.Context, faults config.ChaosConfig) (*dalc.DAResponse, error) {
+	if faults.Delay > 0 {
+		timer := time.NewTimer(faults.Delay)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return &dalc.DAResponse{Code: dalc.StatusCode_STATUS_CODE_TIMEOUT, Message: ctx.Err().Error()}, ctx.Err()
+		}
+	}
+
+	switch {
+	case lc.roll(faults.TimeoutRate):
+		// hang until the caller gives up, which cancels ctx, or the server
+		// stops
+		<-ctx.Done()
+		return &dalc.DAResponse{
+			Code:    dalc.StatusCode_STATUS_CODE_TIMEOUT,
+			Message: ErrInjectedTimeout.Error(),
+		}, status.Error(codes.DeadlineExceeded, ErrInjectedTimeout.Error())
+	case lc.roll(faults.ErrorRate):
+		return &dalc.DAResponse{
+			Code:    dalc.StatusCode_STATUS_CODE_ERROR,
+			Message: ErrInjected.Error(),
+		}, ErrInjected
+	}
+	return nil, nil
+}
+
+// roll returns true with the provided probability
+func (lc *LightClient) roll(rate float64) bool {
+	if rate <= 0 {
+		return false
+	}
+	lc.randMtx.Lock()
+	defer lc.randMtx.Unlock()
+	return lc.rand.Float64() < rate
+}
+
+func validate(cfg config.ChaosConfig) error {
+	rates := []struct {
+		name string
+		rate float64
+	}{
+		{"error-rate", cfg.ErrorRate},
+		{"timeout-rate", cfg.TimeoutRate},
+		{"drop-rate", cfg.DropRate},
+		{"reorder-rate", cfg.ReorderRate},
+		{"duplicate-rate", cfg.DuplicateRate},
+		{"false-availability-rate", cfg.FalseAvailabilityRate},
+	}
+	for _, r := range rates {
+		if r.rate < 0 || r.rate > 1 {
+			return fmt.Errorf("chaos: %s must be between 0 and 1, got %v", r.name, r.rate)
+		}
+	}
+	if cfg.Delay < 0 {
+		return fmt.Errorf("chaos: delay must not be negative, got %v", cfg.Delay)
+	}
+	return nil
+}
+
+func toFaults(cfg config.ChaosConfig) *dalc.Faults {
+	return &dalc.Faults{
+		DelayMs:               uint64(cfg.Delay.Milliseconds()),
+		ErrorRate:             cfg.ErrorRate,
+		TimeoutRate:           cfg.TimeoutRate,
+		DropRate:              cfg.DropRate,
+		ReorderRate:           cfg.ReorderRate,
+		DuplicateRate:         cfg.DuplicateRate,
+		FalseAvailabilityRate: cfg.FalseAvailabilityRate,
+	}
+}
+
+func fromFaults(faults *dalc.Faults) config.ChaosConfig {
+	return config.ChaosConfig{
+		Delay:                 time.Duration(faults.GetDelayMs()) * time.Millisecond,
+		ErrorRate:             faults.GetErrorRate(),
+		TimeoutRate:           faults.GetTimeoutRate(),
+		DropRate:              faults.GetDropRate(),
+		ReorderRate:           faults.GetReorderRate(),
+		DuplicateRate:         faults.GetDuplicateRate(),
+		FalseAvailabilityRate: faults.GetFalseAvailabilityRate(),
+	}
+}
@@ -0,0 +1,96 @@
+package chaos
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/dalc/config"
+	"github.com/celestiaorg/dalc/mock"
+	"github.com/celestiaorg/dalc/proto/dalc"
+	"github.com/celestiaorg/dalc/proto/optimint"
+)
+
+var namespace = []byte{1, 2, 3, 4, 5, 6, 7, 8}
+
+func TestInjectedErrors(t *testing.T) {
+	ctx := context.Background()
+	lc, err := New(mock.New(namespace, 0), config.ChaosConfig{Enabled: true, ErrorRate: 1})
+	require.NoError(t, err)
+
+	resp, err := lc.SubmitBlock(ctx, &dalc.SubmitBlockRequest{Block: testBlock(1)})
+	assert.ErrorIs(t, err, ErrInjected)
+	assert.Equal(t, dalc.StatusCode_STATUS_CODE_ERROR, resp.Result.Code)
+
+	// faults can be removed at runtime
+	_, err = lc.SetFaults(ctx, &dalc.SetFaultsRequest{Faults: &dalc.Faults{}})
+	require.NoError(t, err)
+
+	resp, err = lc.SubmitBlock(ctx, &dalc.SubmitBlockRequest{Block: testBlock(1)})
+	require.NoError(t, err)
+	assert.Equal(t, dalc.StatusCode_STATUS_CODE_SUCCESS, resp.Result.Code)
+}
+
+func TestInjectedTimeouts(t *testing.T) {
+	lc, err := New(mock.New(namespace, 0), config.ChaosConfig{Enabled: true, TimeoutRate: 1})
+	require.NoError(t, err)
+
+	// requests hang until cancelled, even without a deadline
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(time.Millisecond*50, cancel)
+	start := time.Now()
+	resp, err := lc.SubmitBlock(ctx, &dalc.SubmitBlockRequest{Block: testBlock(1)})
+	assert.GreaterOrEqual(t, time.Since(start), time.Millisecond*50)
+	assert.ErrorContains(t, err, ErrInjectedTimeout.Error())
+	assert.Equal(t, dalc.StatusCode_STATUS_CODE_TIMEOUT, resp.Result.Code)
+}
+
+func TestRetrieveFaults(t *testing.T) {
+	ctx := context.Background()
+	next := mock.New(namespace, 0)
+	_, err := next.SubmitBlock(ctx, &dalc.SubmitBlockRequest{Block: testBlock(1)})
+	require.NoError(t, err)
+
+	lc, err := New(next, config.ChaosConfig{Enabled: true, DuplicateRate: 1, FalseAvailabilityRate: 1})
+	require.NoError(t, err)
+
+	retrieveResp, err := lc.RetrieveBlocks(ctx, &dalc.RetrieveBlocksRequest{DataLayerHeight: 1})
+	require.NoError(t, err)
+	assert.Len(t, retrieveResp.Blocks, 2)
+
+	availResp, err := lc.CheckBlockAvailability(ctx, &dalc.CheckBlockAvailabilityRequest{DataLayerHeight: 1})
+	require.NoError(t, err)
+	assert.False(t, availResp.DataAvailable)
+
+	_, err = lc.SetFaults(ctx, &dalc.SetFaultsRequest{Faults: &dalc.Faults{DropRate: 1}})
+	require.NoError(t, err)
+
+	retrieveResp, err = lc.RetrieveBlocks(ctx, &dalc.RetrieveBlocksRequest{DataLayerHeight: 1})
+	require.NoError(t, err)
+	assert.Empty(t, retrieveResp.Blocks)
+}
+
+func TestInvalidFaults(t *testing.T) {
+	_, err := New(mock.New(namespace, 0), config.ChaosConfig{ErrorRate: 2})
+	assert.Error(t, err)
+
+	lc, err := New(mock.New(namespace, 0), config.DefaultChaosConfig())
+	require.NoError(t, err)
+	_, err = lc.SetFaults(context.Background(), &dalc.SetFaultsRequest{Faults: &dalc.Faults{DropRate: -1}})
+	assert.Error(t, err)
+}
+
+func testBlock(height uint64) *optimint.Block {
+	return &optimint.Block{
+		Header: &optimint.Header{
+			Height:      height,
+			NamespaceId: namespace,
+		},
+		Data: &optimint.Data{
+			Txs: [][]byte{{1}, {2}, {3, 4}},
+		},
+	}
+}
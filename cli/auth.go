@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/celestiaorg/dalc/auth"
+)
+
+// AuthCmd returns the commands managing the authorization of requests to the
+// dalc. pathFlag is the name of the flag holding the path of the node store.
+func AuthCmd(pathFlag string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auth [subcommand]",
+		Short: "Manage the authorization of requests to the dalc",
+		Args:  cobra.NoArgs,
+	}
+	cmd.AddCommand(tokenCmd(pathFlag))
+	return cmd
+}
+
+func tokenCmd(pathFlag string) *cobra.Command {
+	var (
+		level    string
+		ttl      time.Duration
+		noExpiry bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "token",
+		Short: "Mints a bearer token granting the permissions of the provided level",
+		Long: `Mints a bearer token granting the permissions of the provided level. Levels include the permissions of the previous ones:
+  read:   RetrieveBlocks and CheckBlockAvailability
+  submit: SubmitBlock
+  admin:  administrative services`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			perms, err := auth.PermissionsForLevel(auth.Permission(level))
+			if err != nil {
+				return err
+			}
+			switch {
+			case noExpiry && ttl != 0:
+				return errors.New("--ttl and --no-expiry are mutually exclusive")
+			case !noExpiry && ttl <= 0:
+				return errors.New("set a positive --ttl, or --no-expiry for a token that never expires")
+			}
+
+			path, err := storePath(cmd, pathFlag)
+			if err != nil {
+				return err
+			}
+			ks, err := OpenKeystore(path)
+			if err != nil {
+				return err
+			}
+			secret, err := auth.LoadOrGenerateSecret(ks)
+			if err != nil {
+				return err
+			}
+
+			token, err := auth.NewToken(secret, perms, ttl)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), token)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&level, "level", string(auth.PermRead), "permission level of the token: read, submit or admin")
+	cmd.Flags().DurationVar(&ttl, "ttl", 0, "duration after which the token expires")
+	cmd.Flags().BoolVar(&noExpiry, "no-expiry", false, "mint a token that never expires instead of setting --ttl")
+	return cmd
+}
@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/dalc/auth"
+)
+
+func TestTokenCmd(t *testing.T) {
+	home := t.TempDir()
+	run := func(args ...string) (string, error) {
+		t.Helper()
+		root := &cobra.Command{Use: "dalc", SilenceUsage: true, SilenceErrors: true}
+		root.PersistentFlags().String("home", home, "")
+		root.AddCommand(AuthCmd("home"))
+		var out bytes.Buffer
+		root.SetOut(&out)
+		root.SetArgs(append([]string{"auth", "token"}, args...))
+		err := root.Execute()
+		return strings.TrimSpace(out.String()), err
+	}
+
+	// tokens that never expire must be asked for
+	_, err := run()
+	assert.Error(t, err)
+	_, err = run("--ttl", "-1h")
+	assert.Error(t, err)
+	_, err = run("--ttl", "1h", "--no-expiry")
+	assert.Error(t, err)
+
+	ks, err := OpenKeystore(home)
+	require.NoError(t, err)
+	secret, err := auth.LoadOrGenerateSecret(ks)
+	require.NoError(t, err)
+
+	token, err := run("--ttl", "1h")
+	require.NoError(t, err)
+	claims, err := auth.VerifyToken(secret, token)
+	require.NoError(t, err)
+	assert.NotZero(t, claims.ExpiresAt)
+
+	token, err = run("--no-expiry", "--level", "admin")
+	require.NoError(t, err)
+	claims, err = auth.VerifyToken(secret, token)
+	require.NoError(t, err)
+	assert.Zero(t, claims.ExpiresAt)
+	assert.True(t, claims.Allows(auth.PermAdmin))
+}
@@ -0,0 +1,29 @@
+// Package cli contains the commands shared by the dalc binaries to manage a
+// dalc without running it.
+package cli
+
+import (
+	"path/filepath"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/spf13/cobra"
+
+	"github.com/celestiaorg/celestia-node/libs/keystore"
+)
+
+// keysDir is the directory of the node store that holds the keystore
+const keysDir = "keys"
+
+// storePath returns the expanded path held by the provided flag
+func storePath(cmd *cobra.Command, pathFlag string) (string, error) {
+	path, err := cmd.Flags().GetString(pathFlag)
+	if err != nil {
+		return "", err
+	}
+	return homedir.Expand(path)
+}
+
+// OpenKeystore opens the keystore of the node store at path
+func OpenKeystore(path string) (keystore.Keystore, error) {
+	return keystore.NewFSKeystore(filepath.Join(path, keysDir))
+}
@@ -0,0 +1,349 @@
+package cli
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/celestiaorg/dalc/auth"
+	"github.com/celestiaorg/dalc/config"
+	"github.com/celestiaorg/dalc/proto/dalc"
+	"github.com/celestiaorg/dalc/proto/optimint"
+)
+
+const (
+	formatJSON  = "json"
+	formatProto = "proto"
+)
+
+// clientOptions configure the connection of the client commands to the dalc
+type clientOptions struct {
+	addr     string
+	token    string
+	tls      bool
+	caFile   string
+	certFile string
+	keyFile  string
+	insecure bool
+	timeout  time.Duration
+}
+
+// ClientCmd returns the commands calling a running dalc. pathFlag is the name
+// of the flag holding the path of the node store, whose config provides the
+// defaults of the connection.
+func ClientCmd(pathFlag string) *cobra.Command {
+	opts := &clientOptions{}
+	cmd := &cobra.Command{
+		Use:   "client [subcommand]",
+		Short: "Call a running dalc",
+		Long: `Call a running dalc. The address and transport security default to those of the
+config, if any. The token is read from $DALC_TOKEN if --token is not set, and
+is only sent without TLS if --insecure is set.`,
+		Args: cobra.NoArgs,
+	}
+	cmd.PersistentFlags().StringVar(&opts.addr, "addr", "", "address of the dalc, defaults to the configured laddr")
+	cmd.PersistentFlags().StringVar(&opts.token, "token", "", "bearer token authorizing the requests")
+	cmd.PersistentFlags().BoolVar(&opts.tls, "tls", false, "connect over TLS, enabled if the configured dalc serves over TLS")
+	cmd.PersistentFlags().StringVar(&opts.caFile, "ca-file", "", "PEM encoded certificate authorities trusted to sign the dalc certificate, the system ones if empty")
+	cmd.PersistentFlags().StringVar(&opts.certFile, "cert-file", "", "PEM encoded client certificate presented to dalcs requiring mutual TLS")
+	cmd.PersistentFlags().StringVar(&opts.keyFile, "key-file", "", "PEM encoded private key of the client certificate")
+	cmd.PersistentFlags().BoolVar(&opts.insecure, "insecure", false, "send the token to dalcs served without TLS, in plaintext")
+	cmd.PersistentFlags().DurationVar(&opts.timeout, "timeout", time.Minute, "timeout of the request")
+
+	cmd.AddCommand(
+		clientSubmitCmd(pathFlag, opts),
+		clientRetrieveCmd(pathFlag, opts),
+		clientCheckCmd(pathFlag, opts),
+		clientInfoCmd(pathFlag, opts),
+	)
+	return cmd
+}
+
+func clientSubmitCmd(pathFlag string, opts *clientOptions) *cobra.Command {
+	var file, format string
+	cmd := &cobra.Command{
+		Use:   "submit",
+		Short: "Submits the block read from a file",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var raw []byte
+			var err error
+			if file == "-" {
+				raw, err = io.ReadAll(cmd.InOrStdin())
+			} else {
+				raw, err = os.ReadFile(file)
+			}
+			if err != nil {
+				return err
+			}
+			block := &optimint.Block{}
+			err = unmarshalBlock(raw, blockFormat(format, file), block)
+			if err != nil {
+				return fmt.Errorf("reading block from %s: %w", file, err)
+			}
+
+			return callDALC(cmd, pathFlag, opts, func(ctx context.Context, client dalc.DALCServiceClient) (proto.Message, error) {
+				resp, err := client.SubmitBlock(ctx, &dalc.SubmitBlockRequest{Block: block})
+				if err != nil {
+					return nil, err
+				}
+				return resp, resultErr(resp.Result)
+			})
+		},
+	}
+	cmd.Flags().StringVar(&file, "file", "", "file holding the block, - reads it from stdin")
+	cmd.Flags().StringVar(&format, "format", "", "format of the block, json or proto, guessed from the file extension if empty")
+	_ = cmd.MarkFlagRequired("file")
+	return cmd
+}
+
+func clientRetrieveCmd(pathFlag string, opts *clientOptions) *cobra.Command {
+	var (
+		height uint64
+		outDir string
+		format string
+	)
+	cmd := &cobra.Command{
+		Use:   "retrieve",
+		Short: "Retrieves the blocks submitted at a data availability height",
+		Long: `Retrieves the blocks submitted at a data availability height. The response is
+printed as JSON, and each block is also written to a file of --out-dir if set.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if format != formatJSON && format != formatProto {
+				return fmt.Errorf("unknown format %q, use json or proto", format)
+			}
+			return callDALC(cmd, pathFlag, opts, func(ctx context.Context, client dalc.DALCServiceClient) (proto.Message, error) {
+				resp, err := client.RetrieveBlocks(ctx, &dalc.RetrieveBlocksRequest{DataLayerHeight: height})
+				if err != nil {
+					return nil, err
+				}
+				if outDir != "" {
+					err = writeBlocks(outDir, format, height, resp.Blocks)
+					if err != nil {
+						return nil, err
+					}
+				}
+				return resp, resultErr(resp.Result)
+			})
+		},
+	}
+	cmd.Flags().Uint64Var(&height, "height", 0, "data availability height to retrieve the blocks of")
+	cmd.Flags().StringVar(&outDir, "out-dir", "", "directory to write the retrieved blocks to")
+	cmd.Flags().StringVar(&format, "format", formatJSON, "format of the written blocks, json or proto")
+	_ = cmd.MarkFlagRequired("height")
+	return cmd
+}
+
+func clientCheckCmd(pathFlag string, opts *clientOptions) *cobra.Command {
+	var height uint64
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "Checks that the data at a data availability height is available",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return callDALC(cmd, pathFlag, opts, func(ctx context.Context, client dalc.DALCServiceClient) (proto.Message, error) {
+				resp, err := client.CheckBlockAvailability(ctx, &dalc.CheckBlockAvailabilityRequest{DataLayerHeight: height})
+				if err != nil {
+					return nil, err
+				}
+				if !resp.DataAvailable {
+					return resp, fmt.Errorf("data at height %d is not available", height)
+				}
+				return resp, nil
+			})
+		},
+	}
+	cmd.Flags().Uint64Var(&height, "height", 0, "data availability height to check")
+	_ = cmd.MarkFlagRequired("height")
+	return cmd
+}
+
+func clientInfoCmd(pathFlag string, opts *clientOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "info",
+		Short: "Describes the dalc",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return callDALC(cmd, pathFlag, opts, func(ctx context.Context, client dalc.DALCServiceClient) (proto.Message, error) {
+				resp, err := client.GetInfo(ctx, &dalc.GetInfoRequest{})
+				if err != nil {
+					return nil, err
+				}
+				return resp, resultErr(resp.Result)
+			})
+		},
+	}
+}
+
+// callDALC dials the dalc, calls it and prints the response as JSON. The
+// error returned by call is returned after the response is printed.
+func callDALC(
+	cmd *cobra.Command,
+	pathFlag string,
+	opts *clientOptions,
+	call func(context.Context, dalc.DALCServiceClient) (proto.Message, error),
+) error {
+	conn, err := dialDALC(cmd, pathFlag, opts)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), opts.timeout)
+	defer cancel()
+	resp, callErr := call(ctx, dalc.NewDALCServiceClient(conn))
+	if resp != nil {
+		marshaler := jsonpb.Marshaler{EmitDefaults: true, Indent: "  "}
+		err = marshaler.Marshal(cmd.OutOrStdout(), resp)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(cmd.OutOrStdout())
+	}
+	return callErr
+}
+
+// dialDALC connects to the dalc, using the config in the node store, if any,
+// for the options that were not set
+func dialDALC(cmd *cobra.Command, pathFlag string, opts *clientOptions) (*grpc.ClientConn, error) {
+	path, err := storePath(cmd, pathFlag)
+	if err != nil {
+		return nil, err
+	}
+	cfg := config.DefaultBaseConfig()
+	var tlsEnabled bool
+	serverCfg, _, err := config.LoadEffective(path, cmd.Flags())
+	switch {
+	case err == nil:
+		cfg = serverCfg.BaseConfig
+		tlsEnabled = serverCfg.TLSConfig.Enabled()
+	case !errors.Is(err, fs.ErrNotExist):
+		return nil, err
+	}
+
+	addr := opts.addr
+	if addr == "" {
+		addr = cfg.ListenAddr
+	}
+	token := opts.token
+	if token == "" {
+		token = os.Getenv("DALC_TOKEN")
+	}
+
+	var dialOpts []grpc.DialOption
+	useTLS := opts.tls || opts.caFile != "" || opts.certFile != "" || tlsEnabled
+	if useTLS {
+		tlsCfg, err := clientTLSConfig(opts)
+		if err != nil {
+			return nil, err
+		}
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(tlsCfg)))
+	} else {
+		dialOpts = append(dialOpts, grpc.WithInsecure())
+	}
+	if token != "" {
+		if !useTLS && !opts.insecure {
+			return nil, errors.New("refusing to send the token without TLS, set --tls or --insecure")
+		}
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(auth.TokenCredentials{Token: token, AllowInsecure: opts.insecure}))
+	}
+	return grpc.Dial(addr, dialOpts...)
+}
+
+func clientTLSConfig(opts *clientOptions) (*tls.Config, error) {
+	tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if opts.caFile != "" {
+		pem, err := os.ReadFile(opts.caFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate found in %s", opts.caFile)
+		}
+		tlsCfg.RootCAs = pool
+	}
+	if opts.certFile != "" {
+		cert, err := tls.LoadX509KeyPair(opts.certFile, opts.keyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+	return tlsCfg, nil
+}
+
+// resultErr returns an error if the result reports a failure
+func resultErr(result *dalc.DAResponse) error {
+	if result.GetCode() == dalc.StatusCode_STATUS_CODE_ERROR {
+		return errors.New(result.Message)
+	}
+	return nil
+}
+
+// blockFormat returns the format of a block file, guessed from its extension
+// if not set
+func blockFormat(format, file string) string {
+	if format != "" {
+		return format
+	}
+	if strings.EqualFold(filepath.Ext(file), ".json") || file == "-" {
+		return formatJSON
+	}
+	return formatProto
+}
+
+func unmarshalBlock(raw []byte, format string, block *optimint.Block) error {
+	switch format {
+	case formatJSON:
+		return jsonpb.UnmarshalString(string(raw), block)
+	case formatProto:
+		return proto.Unmarshal(raw, block)
+	default:
+		return fmt.Errorf("unknown format %q, use json or proto", format)
+	}
+}
+
+// writeBlocks writes each block to a file of dir named after the height and
+// its index
+func writeBlocks(dir, format string, height uint64, blocks []*optimint.Block) error {
+	err := os.MkdirAll(dir, 0750)
+	if err != nil {
+		return err
+	}
+	for i, block := range blocks {
+		var raw []byte
+		ext := ".pb"
+		if format == formatJSON {
+			ext = ".json"
+			var s string
+			s, err = (&jsonpb.Marshaler{Indent: "  "}).MarshalToString(block)
+			raw = []byte(s)
+		} else {
+			raw, err = proto.Marshal(block)
+		}
+		if err != nil {
+			return err
+		}
+		err = os.WriteFile(filepath.Join(dir, fmt.Sprintf("%d-%d%s", height, i, ext)), raw, 0600)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
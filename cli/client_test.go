@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/celestiaorg/dalc/config"
+	"github.com/celestiaorg/dalc/mock"
+	"github.com/celestiaorg/dalc/proto/dalc"
+	"github.com/celestiaorg/dalc/proto/optimint"
+)
+
+func TestClientCmd(t *testing.T) {
+	ctx := context.Background()
+	namespace := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	lc := mock.New(namespace, 0)
+	require.NoError(t, lc.Start(ctx))
+	t.Cleanup(func() { lc.Stop(ctx) }) //nolint:errcheck
+
+	srv := grpc.NewServer()
+	dalc.RegisterDALCServiceServer(srv, lc)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go srv.Serve(lis) //nolint:errcheck
+	t.Cleanup(srv.Stop)
+
+	home := t.TempDir()
+	run := func(args ...string) (string, error) {
+		t.Helper()
+		root := &cobra.Command{Use: "dalc"}
+		root.PersistentFlags().String("home", home, "")
+		root.AddCommand(ClientCmd("home"))
+		var out bytes.Buffer
+		root.SetOut(&out)
+		root.SetErr(&out)
+		root.SetArgs(append([]string{"client", "--addr", lis.Addr().String()}, args...))
+		err := root.ExecuteContext(ctx)
+		return out.String(), err
+	}
+
+	block := &optimint.Block{
+		Header:     &optimint.Header{Height: 1, NamespaceId: namespace},
+		Data:       &optimint.Data{Txs: [][]byte{{1}, {2, 3}}},
+		LastCommit: &optimint.Commit{Height: 1},
+	}
+	blockJSON, err := (&jsonpb.Marshaler{}).MarshalToString(block)
+	require.NoError(t, err)
+	blockFile := filepath.Join(home, "block.json")
+	require.NoError(t, os.WriteFile(blockFile, []byte(blockJSON), 0600))
+
+	out, err := run("submit", "--file", blockFile)
+	require.NoError(t, err)
+	var submitResp dalc.SubmitBlockResponse
+	require.NoError(t, jsonpb.UnmarshalString(out, &submitResp))
+	assert.Equal(t, dalc.StatusCode_STATUS_CODE_SUCCESS, submitResp.Result.Code)
+	height := submitResp.Result.DataLayerHeight
+	heightArg := strconv.FormatUint(height, 10)
+
+	_, err = run("check", "--height", heightArg)
+	require.NoError(t, err)
+	_, err = run("check", "--height", "1000")
+	assert.Error(t, err)
+
+	outDir := filepath.Join(home, "blocks")
+	out, err = run("retrieve", "--height", heightArg, "--out-dir", outDir, "--format", "proto")
+	require.NoError(t, err)
+	var retrieveResp dalc.RetrieveBlocksResponse
+	require.NoError(t, jsonpb.UnmarshalString(out, &retrieveResp))
+	require.Len(t, retrieveResp.Blocks, 1)
+	assert.True(t, proto.Equal(block, retrieveResp.Blocks[0]))
+
+	// written blocks can be submitted again
+	written, err := filepath.Glob(filepath.Join(outDir, "*.pb"))
+	require.NoError(t, err)
+	require.Len(t, written, 1)
+	_, err = run("submit", "--file", written[0])
+	assert.NoError(t, err)
+
+	// tokens are only sent in plaintext with --insecure
+	_, err = run("info", "--token", "secret")
+	assert.ErrorContains(t, err, "refusing to send the token without TLS")
+	_, err = run("check", "--height", heightArg, "--token", "secret", "--insecure")
+	assert.NoError(t, err)
+
+	// configs that can not be loaded are reported
+	require.NoError(t, os.WriteFile(config.ConfigPath(home), []byte("[base\n"), 0600))
+	_, err = run("check", "--height", heightArg)
+	assert.Error(t, err)
+}
@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/BurntSushi/toml"
+	"github.com/spf13/cobra"
+
+	"github.com/celestiaorg/dalc/config"
+)
+
+// secretKeys are the keys of the config whose value is not printed
+var secretKeys = map[string]bool{
+	"signer.remote-token": true,
+}
+
+// ConfigCmd returns the commands inspecting and migrating the dalc config.
+// pathFlag is the name of the flag holding the path of the node store.
+func ConfigCmd(pathFlag string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config [subcommand]",
+		Short: "Inspect and migrate the dalc config",
+		Args:  cobra.NoArgs,
+	}
+	cmd.AddCommand(
+		configShowCmd(pathFlag),
+		configMigrateCmd(pathFlag),
+		configDiffCmd(pathFlag),
+	)
+	return cmd
+}
+
+func configShowCmd(pathFlag string) *cobra.Command {
+	var effective bool
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Prints the config",
+		Long: `Prints the config of the node store, with the defaults of the fields it does not
+set. With --effective, prints the value of each field once overridden by the
+DALC_* environment variables and the --dalc.* flags, along with where it was set.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path, err := storePath(cmd, pathFlag)
+			if err != nil {
+				return err
+			}
+
+			if !effective {
+				cfg, err := config.Load(path)
+				if err != nil {
+					return err
+				}
+				return toml.NewEncoder(cmd.OutOrStdout()).Encode(cfg)
+			}
+
+			_, settings, err := config.LoadEffective(path, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "KEY\tVALUE\tSOURCE")
+			for _, s := range settings {
+				value := s.Value
+				if secretKeys[s.Key] && value != "" {
+					value = "<redacted>"
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\n", s.Key, value, s.Source)
+			}
+			return w.Flush()
+		},
+	}
+	cmd.Flags().BoolVar(&effective, "effective", false, "print the values once overridden by the environment and flags, along with their source")
+	return cmd
+}
+
+func configMigrateCmd(pathFlag string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrates the config to the current version, backing it up first",
+		Long: `Migrates the config to the current version, backing it up first. The fields it
+does not set, such as fields added by newer versions of the dalc, take their
+default value while the values it sets are kept. Configs of older versions are
+also migrated when the dalc starts.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path, err := storePath(cmd, pathFlag)
+			if err != nil {
+				return err
+			}
+			m, backup, err := config.Migrate(path)
+			if err != nil {
+				return err
+			}
+			if !m.Needed() {
+				fmt.Fprintln(cmd.OutOrStdout(), "config is up to date")
+				return nil
+			}
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+			fmt.Fprintf(w, "migrated %s from version %d to %d, backed up to %s\n",
+				config.ConfigPath(path), m.From, config.Version, backup)
+			if len(m.Added) > 0 {
+				fmt.Fprintf(w, "added %d fields:\n", len(m.Added))
+			}
+			for _, s := range m.Added {
+				fmt.Fprintf(w, "  %s\t%s\n", s.Key, s.Value)
+			}
+			return w.Flush()
+		},
+	}
+}
+
+func configDiffCmd(pathFlag string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "diff",
+		Short: "Shows the changes config migrate would make",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path, err := storePath(cmd, pathFlag)
+			if err != nil {
+				return err
+			}
+			m, err := config.PlanMigration(path)
+			if err != nil {
+				return err
+			}
+			if !m.Needed() {
+				fmt.Fprintln(cmd.OutOrStdout(), "config is up to date")
+				return nil
+			}
+			diff, err := m.Diff()
+			if err != nil {
+				return err
+			}
+			fmt.Fprint(cmd.OutOrStdout(), diff)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,32 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/dalc/config"
+)
+
+func TestConfigShowEffective(t *testing.T) {
+	home := t.TempDir()
+	require.NoError(t, config.DefaultServerConfig(home).Save(home))
+	t.Setenv("DALC_SIGNER_REMOTE_TOKEN", "secret")
+
+	root := &cobra.Command{Use: "dalc"}
+	root.PersistentFlags().String("home", home, "")
+	root.PersistentFlags().AddFlagSet(config.Flags())
+	root.AddCommand(ConfigCmd("home"))
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetArgs([]string{"config", "show", "--effective", "--dalc.block-submitter.chain-id=devnet"})
+	require.NoError(t, root.Execute())
+
+	assert.Regexp(t, `block-submitter.chain-id\s+devnet\s+--dalc.block-submitter.chain-id`, out.String())
+	assert.Regexp(t, `base.laddr\s+0.0.0.0:4200\s+file`, out.String())
+	assert.Regexp(t, `signer.remote-token\s+<redacted>\s+\$DALC_SIGNER_REMOTE_TOKEN`, out.String())
+	assert.NotContains(t, out.String(), "secret")
+}
@@ -0,0 +1,243 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/celestiaorg/dalc/config"
+	"github.com/celestiaorg/dalc/signer"
+)
+
+// KeysCmd returns the commands managing the keys of the keyring the dalc signs
+// transactions with. pathFlag is the name of the flag holding the path of the
+// node store.
+func KeysCmd(pathFlag string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "keys [subcommand]",
+		Short: "Manage the keys of the keyring the dalc signs transactions with",
+		Long: `Manage the keys of the keyring the dalc signs transactions with. The keyring is
+the one set in the [keyring] section of the config. Commands taking a key name
+default to the first configured signer account.`,
+		Args: cobra.NoArgs,
+	}
+	cmd.AddCommand(
+		keysAddCmd(pathFlag),
+		keysImportCmd(pathFlag),
+		keysListCmd(pathFlag),
+		keysShowCmd(pathFlag),
+		keysExportCmd(pathFlag),
+		keysDeleteCmd(pathFlag),
+	)
+	return cmd
+}
+
+func keysAddCmd(pathFlag string) *cobra.Command {
+	var recoverKey bool
+	cmd := &cobra.Command{
+		Use:   "add [name]",
+		Short: "Creates a new key, or recovers one from its mnemonic with --recover",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kr, name, err := openKeyring(cmd, pathFlag, args)
+			if err != nil {
+				return err
+			}
+
+			if recoverKey {
+				mnemonic, err := input.GetString("Enter your bip39 mnemonic", bufio.NewReader(cmd.InOrStdin()))
+				if err != nil {
+					return err
+				}
+				info, err := kr.NewAccount(name, mnemonic, "", sdk.FullFundraiserPath, hd.Secp256k1)
+				if err != nil {
+					return err
+				}
+				printKey(cmd, info)
+				return nil
+			}
+
+			info, mnemonic, err := kr.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, "", hd.Secp256k1)
+			if err != nil {
+				return err
+			}
+			printKey(cmd, info)
+			fmt.Fprintf(cmd.OutOrStdout(), "\nWrite this mnemonic down and keep it safe, it is the only way to recover the key:\n\n%s\n", mnemonic)
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&recoverKey, "recover", false, "recover the key from a mnemonic read from stdin")
+	return cmd
+}
+
+func keysImportCmd(pathFlag string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "import <name> <keyfile>",
+		Short: "Imports a key from an ASCII armored private key file, such as one written by export",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kr, name, err := openKeyring(cmd, pathFlag, args[:1])
+			if err != nil {
+				return err
+			}
+			armor, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			passphrase, err := input.GetPassword("Enter the passphrase decrypting the key:", bufio.NewReader(cmd.InOrStdin()))
+			if err != nil {
+				return err
+			}
+			err = kr.ImportPrivKey(name, string(armor), passphrase)
+			if err != nil {
+				return err
+			}
+			info, err := kr.Key(name)
+			if err != nil {
+				return err
+			}
+			printKey(cmd, info)
+			return nil
+		},
+	}
+}
+
+func keysListCmd(pathFlag string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "Lists the keys of the keyring",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kr, _, err := openKeyring(cmd, pathFlag, nil)
+			if err != nil {
+				return err
+			}
+			infos, err := kr.List()
+			if err != nil {
+				return err
+			}
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "NAME\tTYPE\tADDRESS")
+			for _, info := range infos {
+				fmt.Fprintf(w, "%s\t%s\t%s\n", info.GetName(), info.GetType(), info.GetAddress())
+			}
+			return w.Flush()
+		},
+	}
+}
+
+func keysShowCmd(pathFlag string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "show [name]",
+		Short: "Shows the address and public key of a key",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kr, name, err := openKeyring(cmd, pathFlag, args)
+			if err != nil {
+				return err
+			}
+			info, err := kr.Key(name)
+			if err != nil {
+				return err
+			}
+			printKey(cmd, info)
+			return nil
+		},
+	}
+}
+
+func keysExportCmd(pathFlag string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "export [name]",
+		Short: "Exports a key as an ASCII armored private key encrypted with a passphrase",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kr, name, err := openKeyring(cmd, pathFlag, args)
+			if err != nil {
+				return err
+			}
+			passphrase, err := input.GetPassword("Enter the passphrase encrypting the exported key:", bufio.NewReader(cmd.InOrStdin()))
+			if err != nil {
+				return err
+			}
+			armor, err := kr.ExportPrivKeyArmor(name, passphrase)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), armor)
+			return nil
+		},
+	}
+}
+
+func keysDeleteCmd(pathFlag string) *cobra.Command {
+	var yes bool
+	cmd := &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Deletes a key from the keyring",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kr, name, err := openKeyring(cmd, pathFlag, args)
+			if err != nil {
+				return err
+			}
+			if !yes {
+				ok, err := input.GetConfirmation(fmt.Sprintf("Delete key %s? Funds held by it are lost unless it is backed up.", name),
+					bufio.NewReader(cmd.InOrStdin()), cmd.ErrOrStderr())
+				if err != nil {
+					return err
+				}
+				if !ok {
+					return errors.New("aborted")
+				}
+			}
+			err = kr.Delete(name)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "deleted key %s\n", name)
+			return nil
+		},
+	}
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "delete without asking for confirmation")
+	return cmd
+}
+
+// openKeyring opens the keyring of the dalc in the node store, as overridden by
+// the environment and the flags, and returns it along with the key name held by
+// args, or the first signer account if args is empty
+func openKeyring(cmd *cobra.Command, pathFlag string, args []string) (keyring.Keyring, string, error) {
+	path, err := storePath(cmd, pathFlag)
+	if err != nil {
+		return nil, "", err
+	}
+	cfg, _, err := config.LoadEffective(path, cmd.Flags())
+	if err != nil {
+		return nil, "", err
+	}
+	kr, err := signer.OpenKeyring(cfg.KeyringAccName, cfg.KeyringConfig)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(args) > 0 {
+		return kr, args[0], nil
+	}
+	return kr, cfg.Accounts()[0], nil
+}
+
+func printKey(cmd *cobra.Command, info keyring.Info) {
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "name:\t%s\n", info.GetName())
+	fmt.Fprintf(w, "type:\t%s\n", info.GetType())
+	fmt.Fprintf(w, "address:\t%s\n", info.GetAddress())
+	fmt.Fprintf(w, "pubkey:\t%s\n", info.GetPubKey())
+	_ = w.Flush()
+}
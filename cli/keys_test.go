@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/dalc/config"
+)
+
+// runKeysCmd runs the keys command with the provided args against the node
+// store in home and returns its output
+func runKeysCmd(t *testing.T, home, stdin string, args ...string) string {
+	t.Helper()
+	root := &cobra.Command{Use: "dalc"}
+	root.PersistentFlags().String("home", home, "")
+	root.AddCommand(KeysCmd("home"))
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetErr(&out)
+	root.SetIn(strings.NewReader(stdin))
+	root.SetArgs(append([]string{"keys"}, args...))
+	require.NoError(t, root.Execute())
+	return out.String()
+}
+
+func TestKeysCmd(t *testing.T) {
+	home := t.TempDir()
+	require.NoError(t, config.DefaultServerConfig(home).Save(home))
+
+	run := func(stdin string, args ...string) string {
+		t.Helper()
+		return runKeysCmd(t, home, stdin, args...)
+	}
+
+	// keys default to the configured signer account
+	out := run("", "add")
+	assert.Contains(t, out, "name:     dalc")
+	assert.Contains(t, out, "mnemonic")
+	shown := run("", "show", "dalc")
+	assert.Contains(t, shown, "address:")
+
+	armor := run("exportpassphrase\n", "export")
+	keyFile := filepath.Join(home, "dalc.key")
+	require.NoError(t, os.WriteFile(keyFile, []byte(armor), 0600))
+
+	run("", "delete", "dalc", "--yes")
+	assert.NotContains(t, run("", "list"), "dalc")
+
+	// the imported key is the exported one
+	run("exportpassphrase\n", "import", "dalc", keyFile)
+	assert.Equal(t, shown, run("", "show"))
+	assert.Contains(t, run("", "list"), "dalc")
+}
+
+func TestKeysCmdEnvironment(t *testing.T) {
+	home := t.TempDir()
+	require.NoError(t, config.DefaultServerConfig(home).Save(home))
+	runKeysCmd(t, home, "", "add")
+
+	// the keyring path of the environment replaces the configured one
+	keyringPath := t.TempDir()
+	t.Setenv("DALC_KEYRING_PATH", keyringPath)
+	assert.NotContains(t, runKeysCmd(t, home, "", "list"), "dalc")
+	runKeysCmd(t, home, "", "add", "other")
+	assert.Contains(t, runKeysCmd(t, home, "", "list"), "other")
+	assert.DirExists(t, filepath.Join(keyringPath, "keyring-test"))
+}
@@ -18,6 +18,8 @@ func main() {
 	plugin := server.NodePlugin{}
 
 	root := nodecmd.NewRootCmd(&plugin)
+	root.PersistentFlags().AddFlagSet(plugin.Flags())
+	root.AddCommand(plugin.Command())
 
 	if err := root.ExecuteContext(nodecmd.WithEnv(context.Background())); err != nil {
 		log.Fatal(err)
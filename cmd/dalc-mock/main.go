@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"log"
+	"net"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/celestiaorg/dalc/chaos"
+	"github.com/celestiaorg/dalc/config"
+	"github.com/celestiaorg/dalc/mock"
+	"github.com/celestiaorg/dalc/proto/dalc"
+)
+
+func main() {
+	if err := newRootCmd().Execute(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func newRootCmd() *cobra.Command {
+	baseCfg := config.DefaultBaseConfig()
+	var (
+		blockTime          time.Duration
+		storeDir           string
+		delay              time.Duration
+		unavailableHeights []uint
+		enableChaos        bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "dalc-mock",
+		Short: "Serves the DALCService using a local mock data availability layer",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespace, err := hex.DecodeString(baseCfg.Namespace)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+			defer cancel()
+
+			opts := []mock.Option{mock.WithDelay(delay)}
+			for _, height := range unavailableHeights {
+				opts = append(opts, mock.WithUnavailableHeights(uint64(height)))
+			}
+			if storeDir != "" {
+				store, err := mock.NewFileStore(storeDir)
+				if err != nil {
+					return err
+				}
+				opts = append(opts, mock.WithStore(store))
+			}
+
+			lc := mock.New(namespace, blockTime, opts...)
+			err = lc.Start(ctx)
+			if err != nil {
+				return err
+			}
+
+			srv := grpc.NewServer()
+			if enableChaos {
+				chaosLC, err := chaos.New(lc, config.ChaosConfig{Enabled: true})
+				if err != nil {
+					return err
+				}
+				dalc.RegisterChaosServiceServer(srv, chaosLC)
+				dalc.RegisterDALCServiceServer(srv, chaosLC)
+			} else {
+				dalc.RegisterDALCServiceServer(srv, lc)
+			}
+
+			lis, err := net.Listen("tcp", baseCfg.ListenAddr)
+			if err != nil {
+				return err
+			}
+
+			errCh := make(chan error, 1)
+			go func() {
+				errCh <- srv.Serve(lis)
+			}()
+			log.Printf("serving mock DALC on %s", lis.Addr())
+
+			select {
+			case err = <-errCh:
+			case <-ctx.Done():
+				srv.GracefulStop()
+			}
+
+			stopErr := lc.Stop(context.Background())
+			if err != nil {
+				return err
+			}
+			return stopErr
+		},
+	}
+
+	cmd.Flags().StringVar(&baseCfg.ListenAddr, "laddr", baseCfg.ListenAddr, "address to serve the DALCService on")
+	cmd.Flags().StringVar(&baseCfg.Namespace, "namespace", baseCfg.Namespace, "hex encoded namespace of the served rollup blocks")
+	cmd.Flags().DurationVar(&blockTime, "block-time", 0, "time between simulated DA blocks, 0 increases the height once per submitted block")
+	cmd.Flags().StringVar(&storeDir, "store-dir", "", "directory to persist submitted blocks in, blocks are only kept in memory if empty")
+	cmd.Flags().DurationVar(&delay, "delay", 0, "delay added to every response")
+	cmd.Flags().UintSliceVar(&unavailableHeights, "unavailable-heights", nil, "DA heights whose data is reported as unavailable")
+	cmd.Flags().BoolVar(&enableChaos, "chaos", false, "register the ChaosService used to inject faults at runtime")
+
+	return cmd
+}
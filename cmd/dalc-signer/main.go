@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/spf13/cobra"
+
+	"github.com/celestiaorg/dalc/config"
+	"github.com/celestiaorg/dalc/signer"
+)
+
+func main() {
+	if err := newRootCmd().Execute(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func newRootCmd() *cobra.Command {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		home = "."
+	}
+	var (
+		laddr          string
+		keyringBackend string
+		keyringPath    string
+		passphraseFile string
+		token          string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "dalc-signer",
+		Short: "Signs the transactions of remote dalcs with the keys of a local keyring",
+		Long: `Signs the transactions of remote dalcs with the keys of a local keyring.
+It is the reference implementation of the signing daemon used by dalcs whose
+signer remote-addr is set.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if token == "" {
+				token = os.Getenv("DALC_SIGNER_TOKEN")
+			}
+			if token == "" {
+				log.Printf("no token set, anyone reaching %s can sign with the keyring", laddr)
+			}
+
+			ring, err := signer.OpenKeyring("dalc", config.KeyringConfig{
+				KeyringBackend: keyringBackend,
+				KeyringPath:    keyringPath,
+				PassphraseEnv:  config.DefaultKeyringConfig("").PassphraseEnv,
+				PassphraseFile: passphraseFile,
+			})
+			if err != nil {
+				return err
+			}
+
+			lis, err := net.Listen("tcp", laddr)
+			if err != nil {
+				return err
+			}
+			srv := &http.Server{Handler: signer.NewHandler(ring, token), ReadHeaderTimeout: time.Second * 10}
+
+			ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+			defer cancel()
+
+			errCh := make(chan error, 1)
+			go func() {
+				errCh <- srv.Serve(lis)
+			}()
+			log.Printf("serving dalc signer on %s", lis.Addr())
+
+			select {
+			case err = <-errCh:
+			case <-ctx.Done():
+				err = srv.Shutdown(context.Background())
+			}
+			if errors.Is(err, http.ErrServerClosed) {
+				return nil
+			}
+			return err
+		},
+	}
+
+	cmd.Flags().StringVar(&laddr, "laddr", "127.0.0.1:4203", "address to serve the signing API on")
+	cmd.Flags().StringVar(&keyringBackend, "keyring-backend", keyring.BackendTest, "backend of the keyring holding the keys")
+	cmd.Flags().StringVar(&keyringPath, "keyring-path", filepath.Join(home, ".dalc-signer"), "path of the keyring holding the keys")
+	cmd.Flags().StringVar(&passphraseFile, "passphrase-file", "", "file holding the passphrase of the file and os keyrings, read from DALC_KEYRING_PASSPHRASE if not set")
+	cmd.Flags().StringVar(&token, "token", "", "bearer token required to sign, read from DALC_SIGNER_TOKEN if empty")
+
+	return cmd
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-node/libs/utils"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/spm/cosmoscmd"
+
+	"github.com/celestiaorg/dalc/cli"
+	"github.com/celestiaorg/dalc/config"
+	"github.com/celestiaorg/dalc/server"
+	"github.com/celestiaorg/dalc/signer"
+)
+
+const homeFlag = "home"
+
+func init() {
+	cosmoscmd.SetPrefixes(app.AccountAddressPrefix)
+}
+
+func main() {
+	if err := newRootCmd().ExecuteContext(context.Background()); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func newRootCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dalc [subcommand]",
+		Short: "Runs the DALC standalone, using the RPC API of a remote celestia-node",
+		Args:  cobra.NoArgs,
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		home = "."
+	}
+	cmd.PersistentFlags().String(homeFlag, filepath.Join(home, ".dalc"), "directory holding the dalc config and keyring")
+	cmd.PersistentFlags().AddFlagSet(config.Flags())
+
+	cmd.AddCommand(
+		initCmd(),
+		startCmd(),
+		cli.AuthCmd(homeFlag),
+		cli.KeysCmd(homeFlag),
+		cli.ClientCmd(homeFlag),
+		cli.ConfigCmd(homeFlag),
+	)
+	return cmd
+}
+
+func initCmd() *cobra.Command {
+	var keyringBackend string
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Writes the default dalc config to the home directory and creates the keyring",
+		Long: `Writes the default dalc config to the home directory and creates the keyring.
+The passphrase of the file and os keyrings is read from $DALC_KEYRING_PASSPHRASE,
+or asked for if it is not set.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			home, err := cmd.Flags().GetString(homeFlag)
+			if err != nil {
+				return err
+			}
+
+			cfgPath := config.ConfigPath(home)
+			if utils.Exists(cfgPath) {
+				log.Printf("config already exists at %s", cfgPath)
+				return nil
+			}
+
+			err = os.MkdirAll(home, 0750)
+			if err != nil {
+				return err
+			}
+			cfg := config.DefaultServerConfig(home)
+			cfg.KeyringBackend = keyringBackend
+			err = cfg.Validate()
+			if err != nil {
+				return err
+			}
+			err = cfg.Save(home)
+			if err != nil {
+				return err
+			}
+			log.Printf("saved default dalc config to %s", cfgPath)
+
+			// sets the passphrase of new file and os keyrings
+			_, err = signer.OpenKeyring(cfg.KeyringAccName, cfg.KeyringConfig)
+			return err
+		},
+	}
+	cmd.Flags().StringVar(&keyringBackend, "keyring-backend", config.DefaultKeyringConfig("").KeyringBackend, "backend of the keyring: test, file or os")
+	return cmd
+}
+
+func startCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:          "start",
+		Short:        "Starts serving the DALCService",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			home, err := cmd.Flags().GetString(homeFlag)
+			if err != nil {
+				return err
+			}
+
+			err = server.MigrateConfig(home)
+			if err != nil {
+				return err
+			}
+			cfg, _, err := config.LoadEffective(home, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			err = cfg.Validate()
+			if err != nil {
+				return err
+			}
+
+			ks, err := cli.OpenKeystore(home)
+			if err != nil {
+				return err
+			}
+
+			srv, err := server.NewRemote(cfg, ks)
+			if err != nil {
+				return err
+			}
+			srv.WatchConfig(config.ConfigPath(home), func() (config.ServerConfig, error) {
+				cfg, _, err := config.LoadEffective(home, cmd.Flags())
+				return cfg, err
+			})
+
+			ctx, cancel := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+			defer cancel()
+
+			err = srv.Start(ctx)
+			if err != nil {
+				return err
+			}
+			log.Printf("serving DALC on %s using celestia-node at %s", srv.Addr(), cfg.NodeRPCAddress)
+
+			select {
+			case <-srv.Err():
+			case <-ctx.Done():
+			}
+			cancel() // ensure we stop reading more signals for start context
+
+			// a second signal stops the server without waiting for in-flight
+			// requests
+			ctx, cancel = signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+			defer cancel()
+			return srv.Stop(ctx)
+		},
+	}
+}
@@ -17,46 +17,106 @@ func ConfigPath(path string) string {
 }
 
 type ServerConfig struct {
+	// Version is the version of the fields of the config, which is used to
+	// migrate configs written by older versions of the dalc
+	Version int `toml:"version"`
+
 	BaseConfig           `toml:"base"`
 	BlockSubmitterConfig `toml:"block-submitter"`
 	KeyringConfig        `toml:"keyring"`
+	ChaosConfig          `toml:"chaos"`
+	TLSConfig            `toml:"tls"`
+	AuthConfig           `toml:"auth"`
+	AppConnConfig        `toml:"celestia-app-conn"`
+	MetricsConfig        `toml:"metrics"`
+	TracingConfig        `toml:"tracing"`
+	HealthConfig         `toml:"health"`
+	GatewayConfig        `toml:"gateway"`
+	BalanceConfig        `toml:"balance"`
+	SignerConfig         `toml:"signer"`
 }
 
-// Save saves the server config to a specific path
-func (cfg ServerConfig) Save(path string) error {
+// Save saves the server config to a specific path. The config is written to a
+// temporary file that replaces the previous one once synced to disk, so that
+// the file is never left partially written. The permissions of the previous
+// file are kept, new files are only accessible by their owner.
+func (cfg ServerConfig) Save(path string) (err error) {
 	path = ConfigPath(path)
-	cfgFile, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0660)
+	perm := os.FileMode(0600)
+	if info, err := os.Stat(path); err == nil {
+		perm = info.Mode().Perm()
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+ConfigFileName+".*")
 	if err != nil {
 		return err
 	}
-	err = toml.NewEncoder(cfgFile).Encode(cfg)
+	defer func() {
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+
+	err = toml.NewEncoder(tmp).Encode(cfg)
 	if err != nil {
 		return err
 	}
-	return cfgFile.Close()
-}
-
-// Load attempts to load the dalc.toml file from the provided path
-func Load(path string) (ServerConfig, error) {
-	path = ConfigPath(path)
-	var cfg ServerConfig
-	rawCfg, err := os.ReadFile(path)
+	err = tmp.Chmod(perm)
 	if err != nil {
-		return cfg, err
+		return err
 	}
-	_, err = toml.Decode(string(rawCfg), &cfg)
+	err = tmp.Sync()
 	if err != nil {
-		return cfg, err
+		return err
+	}
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+	err = os.Rename(tmp.Name(), path)
+	if err != nil {
+		return err
+	}
+	return syncDir(filepath.Dir(path))
+}
+
+// syncDir syncs a directory so that the files renamed into it persist
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
 	}
-	return cfg, nil
+	defer d.Close()
+	return d.Sync()
+}
+
+// Load attempts to load the dalc.toml file from the provided path. Fields that
+// are not set in the file keep their default value. Keys that do not match a
+// field of the config are rejected, as they are likely typos. Configs of older
+// versions are migrated in memory, see Migrate.
+func Load(path string) (ServerConfig, error) {
+	cf, err := readFile(path)
+	return cf.cfg, err
 }
 
 // DefaultServerConfig returns the default ServerConfig
 func DefaultServerConfig(path string) ServerConfig {
 	return ServerConfig{
+		Version:              Version,
 		BaseConfig:           DefaultBaseConfig(),
 		BlockSubmitterConfig: DefaultBlockSubmitterConfig(),
 		KeyringConfig:        DefaultKeyringConfig(path),
+		ChaosConfig:          DefaultChaosConfig(),
+		TLSConfig:            DefaultTLSConfig(),
+		AuthConfig:           DefaultAuthConfig(),
+		AppConnConfig:        DefaultAppConnConfig(),
+		MetricsConfig:        DefaultMetricsConfig(),
+		TracingConfig:        DefaultTracingConfig(),
+		HealthConfig:         DefaultHealthConfig(),
+		GatewayConfig:        DefaultGatewayConfig(),
+		BalanceConfig:        DefaultBalanceConfig(),
+		SignerConfig:         DefaultSignerConfig(),
 	}
 }
 
@@ -64,12 +124,26 @@ func DefaultServerConfig(path string) ServerConfig {
 type BaseConfig struct {
 	ListenAddr string `toml:"laddr"`
 	Namespace  string `toml:"namespace"`
+	// NodeRPCAddress is the address of the RPC API of the celestia-node used
+	// to read data when the dalc is run standalone instead of being embedded
+	// in a celestia-node, which is served from celestia-node v0.3.0 on.
+	// Defaults to "http://127.0.0.1:26658"
+	NodeRPCAddress string `toml:"celestia-node-rpc-addr"`
+	// ShutdownTimeout is the amount of time in-flight requests are given to
+	// complete when stopping, after which they are cancelled. Zero waits for
+	// as long as the node allows. Defaults to 30 seconds
+	ShutdownTimeout time.Duration `toml:"shutdown-timeout"`
+	// LogLevel is the level of the dalc loggers, such as "debug" or "warn".
+	// Defaults to "", the level set for the celestia-node
+	LogLevel string `toml:"log-level"`
 }
 
 func DefaultBaseConfig() BaseConfig {
 	return BaseConfig{
-		ListenAddr: "0.0.0.0:4200",
-		Namespace:  "0102030405060708",
+		ListenAddr:      "0.0.0.0:4200",
+		Namespace:       "0102030405060708",
+		NodeRPCAddress:  "http://127.0.0.1:26658",
+		ShutdownTimeout: time.Second * 30,
 	}
 }
 
@@ -104,6 +178,18 @@ type BlockSubmitterConfig struct {
 	// KeyringAccName is the name of the account registered in the keyring
 	// for the `From` address field. Defaults to "test"
 	KeyringAccName string `toml:"keyring-account-name"`
+	// KeyringAccNames are the names of the accounts registered in the
+	// keyring that sign transactions in parallel, each submission being
+	// signed by the next idle account. Replaces KeyringAccName when set
+	KeyringAccNames []string `toml:"keyring-account-names"`
+}
+
+// Accounts returns the names of the keyring accounts signing transactions
+func (cfg BlockSubmitterConfig) Accounts() []string {
+	if len(cfg.KeyringAccNames) > 0 {
+		return cfg.KeyringAccNames
+	}
+	return []string{cfg.KeyringAccName}
 }
 
 // DefaultBlockSubmitterConfig returns the default configurations for the
@@ -129,6 +215,18 @@ type KeyringConfig struct {
 	KeyringBackend string `toml:"backend"`
 	// KeyringPath specifies the path do which any keyring data is stored.
 	KeyringPath string `toml:"path"`
+	// PassphraseEnv is the name of the environment variable holding the
+	// passphrase of the file and os backends. Defaults to
+	// "DALC_KEYRING_PASSPHRASE"
+	PassphraseEnv string `toml:"passphrase-env"`
+	// PassphraseFile is the path to a file holding the passphrase of the file
+	// and os backends, which must not be accessible by group or others. Used
+	// when the environment variable is not set. Defaults to ""
+	PassphraseFile string `toml:"passphrase-file"`
+	// AllowInsecureBackend allows the dalc to start with a backend storing
+	// the keys unencrypted on a chain other than the "test" chain. Defaults
+	// to false
+	AllowInsecureBackend bool `toml:"allow-insecure-backend"`
 }
 
 // DefaultKeyringConfig returns the default configuration of the Keyring portion
@@ -137,5 +235,268 @@ func DefaultKeyringConfig(path string) KeyringConfig {
 	return KeyringConfig{
 		KeyringBackend: "test",
 		KeyringPath:    path,
+		PassphraseEnv:  "DALC_KEYRING_PASSPHRASE",
+	}
+}
+
+// InsecureBackend returns true if the backend stores the keys unencrypted
+func (cfg KeyringConfig) InsecureBackend() bool {
+	return cfg.KeyringBackend == "test" || cfg.KeyringBackend == "memory"
+}
+
+// TLSConfig configures transport security for the grpc server of the dalc
+type TLSConfig struct {
+	// CertFile is the path to the PEM encoded certificate presented to
+	// clients. TLS is disabled if empty
+	CertFile string `toml:"cert-file"`
+	// KeyFile is the path to the PEM encoded private key of the certificate
+	KeyFile string `toml:"key-file"`
+	// ClientCAFile is the path to the PEM encoded certificate authorities
+	// trusted to sign client certificates. If set, clients must present a
+	// certificate signed by one of them (mutual TLS)
+	ClientCAFile string `toml:"client-ca-file"`
+}
+
+// Enabled returns true if the grpc server should be served over TLS
+func (cfg TLSConfig) Enabled() bool {
+	return cfg.CertFile != ""
+}
+
+// DefaultTLSConfig returns the default configuration of the TLS portion of the
+// ServerConfig, which serves without TLS
+func DefaultTLSConfig() TLSConfig {
+	return TLSConfig{}
+}
+
+// AppConnConfig configures the grpc connection to the celestia-app node used
+// to submit transactions and query accounts
+type AppConnConfig struct {
+	// TLS connects to the celestia-app node over TLS, verifying its
+	// certificate with the system roots unless CAFile is set. Defaults to
+	// false
+	TLS bool `toml:"tls"`
+	// CAFile is the path to the PEM encoded certificate authorities trusted
+	// to sign the certificate of the celestia-app node. Implies TLS
+	CAFile string `toml:"ca-file"`
+	// CertFile and KeyFile are the paths to the PEM encoded client
+	// certificate and key presented to the celestia-app node. Implies TLS
+	CertFile string `toml:"cert-file"`
+	KeyFile  string `toml:"key-file"`
+	// ServerName overrides the name used to verify the certificate of the
+	// celestia-app node, which defaults to the host of celestia-grpc-addr
+	ServerName string `toml:"server-name"`
+	// KeepaliveTime is the interval after which the connection is pinged
+	// when idle. Zero disables keepalive pings. Servers reject pings more
+	// frequent than their enforcement policy, which is 5 minutes by default
+	KeepaliveTime time.Duration `toml:"keepalive-time"`
+	// KeepaliveTimeout is the amount of time waited for a ping
+	// acknowledgement before the connection is closed. Defaults to 20 seconds
+	KeepaliveTimeout time.Duration `toml:"keepalive-timeout"`
+	// ReconnectBaseDelay and ReconnectMaxDelay bound the exponential backoff
+	// between reconnection attempts. Default to 1 second and 2 minutes
+	ReconnectBaseDelay time.Duration `toml:"reconnect-base-delay"`
+	ReconnectMaxDelay  time.Duration `toml:"reconnect-max-delay"`
+	// MinConnectTimeout is the minimum amount of time given to each
+	// connection attempt. Defaults to 20 seconds
+	MinConnectTimeout time.Duration `toml:"min-connect-timeout"`
+}
+
+// TLSEnabled returns true if the connection to the celestia-app node should
+// use TLS
+func (cfg AppConnConfig) TLSEnabled() bool {
+	return cfg.TLS || cfg.CAFile != "" || cfg.CertFile != ""
+}
+
+// DefaultAppConnConfig returns the default configuration of the
+// AppConn portion of the ServerConfig, which connects without TLS
+func DefaultAppConnConfig() AppConnConfig {
+	return AppConnConfig{
+		KeepaliveTimeout:   time.Second * 20,
+		ReconnectBaseDelay: time.Second,
+		ReconnectMaxDelay:  time.Minute * 2,
+		MinConnectTimeout:  time.Second * 20,
+	}
+}
+
+// MetricsConfig configures the prometheus metrics of the dalc
+type MetricsConfig struct {
+	// Enabled serves the metrics over http at /metrics. Defaults to false
+	Enabled bool `toml:"enabled"`
+	// Address is the address the metrics are served on. Defaults to
+	// "127.0.0.1:4201"
+	Address string `toml:"laddr"`
+}
+
+// DefaultMetricsConfig returns the default configuration of the Metrics
+// portion of the ServerConfig
+func DefaultMetricsConfig() MetricsConfig {
+	return MetricsConfig{
+		Address: "127.0.0.1:4201",
+	}
+}
+
+// TracingConfig configures the OpenTelemetry tracing of the dalc
+type TracingConfig struct {
+	// Exporter is where spans are sent: "otlp-grpc", "otlp-http" or "stdout".
+	// Tracing is disabled if empty, which is the default
+	Exporter string `toml:"exporter"`
+	// Endpoint is the host and port of the OTLP collector. Defaults to
+	// "localhost:4317", the OTLP grpc port
+	Endpoint string `toml:"endpoint"`
+	// Insecure sends spans to the collector without TLS. Defaults to false
+	Insecure bool `toml:"insecure"`
+	// SampleRate is the fraction of traces started by the dalc that are
+	// recorded. Traces started by optimint follow its sampling decision.
+	// Defaults to 1
+	SampleRate float64 `toml:"sample-rate"`
+	// ServiceName identifies the dalc in the traces. Defaults to "dalc"
+	ServiceName string `toml:"service-name"`
+}
+
+// DefaultTracingConfig returns the default configuration of the Tracing
+// portion of the ServerConfig, which disables tracing
+func DefaultTracingConfig() TracingConfig {
+	return TracingConfig{
+		Endpoint:    "localhost:4317",
+		SampleRate:  1,
+		ServiceName: "dalc",
 	}
 }
+
+// HealthConfig configures the grpc health checking of the dalc
+type HealthConfig struct {
+	// CheckInterval is the interval at which the readiness of the dalc is
+	// checked. Defaults to 15 seconds
+	CheckInterval time.Duration `toml:"check-interval"`
+	// MaxHeadAge is how old the latest celestia header known to the node
+	// can be before the dalc is considered out of sync. It must be positive.
+	// Defaults to 2 minutes
+	MaxHeadAge time.Duration `toml:"max-head-age"`
+	// Reflection registers the grpc server reflection service, which lets
+	// tools like grpcurl discover the services. It requires an admin token
+	// when authorization is enabled. Defaults to false
+	Reflection bool `toml:"reflection"`
+}
+
+// DefaultHealthConfig returns the default configuration of the Health portion
+// of the ServerConfig
+func DefaultHealthConfig() HealthConfig {
+	return HealthConfig{
+		CheckInterval: time.Second * 15,
+		MaxHeadAge:    time.Minute * 2,
+	}
+}
+
+// GatewayConfig configures the http gateway serving the DALCService with JSON
+// bodies
+type GatewayConfig struct {
+	// Enabled serves the gateway. It uses the same TLS and authorization
+	// settings as the grpc server. Defaults to false
+	Enabled bool `toml:"enabled"`
+	// Address is the address the gateway is served on. Defaults to
+	// "127.0.0.1:4202"
+	Address string `toml:"laddr"`
+}
+
+// DefaultGatewayConfig returns the default configuration of the Gateway
+// portion of the ServerConfig
+func DefaultGatewayConfig() GatewayConfig {
+	return GatewayConfig{
+		Address: "127.0.0.1:4202",
+	}
+}
+
+// SignerConfig configures where the keys signing transactions are held
+type SignerConfig struct {
+	// RemoteAddress is the address of the HTTP API of a remote signing
+	// daemon holding the keys. Defaults to "", the keys being held by the
+	// local keyring
+	RemoteAddress string `toml:"remote-addr"`
+	// RemoteToken is the bearer token authenticating the requests to the
+	// remote signing daemon. It is only sent over https or to a loopback
+	// address unless Insecure is set. Defaults to ""
+	RemoteToken string `toml:"remote-token"`
+	// RemoteTimeout is the amount of time waited for the remote signing
+	// daemon to sign. Defaults to 10 seconds
+	RemoteTimeout time.Duration `toml:"remote-timeout"`
+	// Insecure allows sending RemoteToken over plain http to a daemon that is
+	// not on a loopback address. Defaults to false
+	Insecure bool `toml:"insecure"`
+}
+
+// DefaultSignerConfig returns the default configuration of the Signer portion
+// of the ServerConfig
+func DefaultSignerConfig() SignerConfig {
+	return SignerConfig{
+		RemoteTimeout: time.Second * 10,
+	}
+}
+
+// BalanceConfig configures the monitoring of the balance of the signer account
+type BalanceConfig struct {
+	// CheckInterval is the interval at which the balance is queried.
+	// Defaults to 1 minute
+	CheckInterval time.Duration `toml:"check-interval"`
+	// LowThreshold is the balance, in the configured denomination, below
+	// which warnings are logged. Warnings are always logged when the balance
+	// can not cover the fee. Defaults to 0
+	LowThreshold uint64 `toml:"low-threshold"`
+	// RefuseUnfunded skips the signer accounts whose last known balance can
+	// not cover the fee, and rejects submissions without broadcasting them
+	// when none can. Defaults to false
+	RefuseUnfunded bool `toml:"refuse-unfunded"`
+}
+
+// DefaultBalanceConfig returns the default configuration of the Balance
+// portion of the ServerConfig
+func DefaultBalanceConfig() BalanceConfig {
+	return BalanceConfig{
+		CheckInterval: time.Minute,
+	}
+}
+
+// AuthConfig configures the authorization of requests to the dalc
+type AuthConfig struct {
+	// Enabled requires every request to carry a bearer token granting the
+	// permission needed by the called method. Tokens are signed with a secret
+	// kept in the keystore of the node store. Defaults to false
+	Enabled bool `toml:"enabled"`
+}
+
+// DefaultAuthConfig returns the default configuration of the Auth portion of
+// the ServerConfig
+func DefaultAuthConfig() AuthConfig {
+	return AuthConfig{}
+}
+
+// ChaosConfig configures the faults injected into the DALCService to test the
+// resilience of rollups. Rates are probabilities between 0 and 1.
+type ChaosConfig struct {
+	// Enabled wraps the DALCService with the fault injection layer and
+	// registers the ChaosService used to change faults at runtime. Defaults
+	// to false
+	Enabled bool `toml:"enabled"`
+	// Delay is added to every response
+	Delay time.Duration `toml:"delay"`
+	// ErrorRate is the rate of requests failing with STATUS_CODE_ERROR
+	ErrorRate float64 `toml:"error-rate"`
+	// TimeoutRate is the rate of requests hanging until they are cancelled,
+	// then failing with STATUS_CODE_TIMEOUT
+	TimeoutRate float64 `toml:"timeout-rate"`
+	// DropRate is the rate of submitted blocks silently not being posted and
+	// of retrieved blocks being left out of the response
+	DropRate float64 `toml:"drop-rate"`
+	// ReorderRate is the rate of retrieved blocks being returned shuffled
+	ReorderRate float64 `toml:"reorder-rate"`
+	// DuplicateRate is the rate of each retrieved block being returned twice
+	DuplicateRate float64 `toml:"duplicate-rate"`
+	// FalseAvailabilityRate is the rate of availability checks reporting the
+	// opposite result
+	FalseAvailabilityRate float64 `toml:"false-availability-rate"`
+}
+
+// DefaultChaosConfig returns the default configuration of the Chaos portion of
+// the ServerConfig, which does not inject any faults
+func DefaultChaosConfig() ChaosConfig {
+	return ChaosConfig{}
+}
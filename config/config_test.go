@@ -0,0 +1,116 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultServerConfigIsValid(t *testing.T) {
+	assert.NoError(t, DefaultServerConfig(t.TempDir()).Validate())
+}
+
+func TestValidateReportsEveryProblem(t *testing.T) {
+	cfg := DefaultServerConfig(t.TempDir())
+	cfg.Namespace = "0102"
+	cfg.GasLimit = 0
+	cfg.BroadcastMode = 4
+	cfg.KeyringBackend = "vault"
+	cfg.TLSConfig.CertFile = "cert.pem"
+	cfg.SampleRate = 2
+	cfg.MaxHeadAge = 0
+
+	err := cfg.Validate()
+	var verr ValidationError
+	require.True(t, errors.As(err, &verr))
+
+	var fields []string
+	for _, fe := range verr {
+		fields = append(fields, fe.Field)
+	}
+	assert.ElementsMatch(t, []string{
+		"base.namespace",
+		"block-submitter.gas-limit",
+		"block-submitter.broadcast-mode",
+		"keyring.backend",
+		"tls.key-file",
+		"tracing.sample-rate",
+		"health.max-head-age",
+	}, fields)
+	assert.Contains(t, err.Error(), "7 problem(s)")
+	assert.Contains(t, err.Error(), `unknown value "vault"`)
+}
+
+func TestValidateInsecureBackend(t *testing.T) {
+	cfg := DefaultServerConfig(t.TempDir())
+	cfg.ChainID = "mamaki"
+	assert.Error(t, cfg.Validate())
+
+	cfg.AllowInsecureBackend = true
+	assert.NoError(t, cfg.Validate())
+
+	// the keyring is not used when signing remotely
+	cfg.AllowInsecureBackend = false
+	cfg.SignerConfig.RemoteAddress = "http://127.0.0.1:4300"
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestValidateRemoteSignerToken(t *testing.T) {
+	cfg := DefaultServerConfig(t.TempDir())
+	cfg.SignerConfig.RemoteAddress = "http://signer.internal:4203"
+	assert.NoError(t, cfg.Validate())
+
+	// the token is only sent in the clear when allowed
+	cfg.RemoteToken = "secret"
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "signer.insecure")
+	cfg.SignerConfig.Insecure = true
+	assert.NoError(t, cfg.Validate())
+
+	cfg.SignerConfig.Insecure = false
+	for _, addr := range []string{"https://signer.internal:4203", "http://127.0.0.1:4203", "http://[::1]:4203", "http://localhost:4203"} {
+		cfg.SignerConfig.RemoteAddress = addr
+		assert.NoError(t, cfg.Validate(), addr)
+	}
+}
+
+func TestLoadRejectsUnknownKeys(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, DefaultServerConfig(dir).Save(dir))
+	cfg, err := Load(dir)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultServerConfig(dir), cfg)
+
+	raw := "[base]\nladdr = \"0.0.0.0:4200\"\n\n[metrics]\nenabeld = true\n"
+	require.NoError(t, os.WriteFile(ConfigPath(dir), []byte(raw), 0600))
+
+	_, err = Load(dir)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown keys metrics.enabeld")
+}
+
+func TestSaveReplacesFile(t *testing.T) {
+	dir := t.TempDir()
+	cfg := DefaultServerConfig(dir)
+	cfg.KeyringAccNames = []string{"a-rather-long-account-name", "another-rather-long-account-name"}
+	require.NoError(t, cfg.Save(dir))
+	require.NoError(t, os.Chmod(ConfigPath(dir), 0640))
+
+	// saving a shorter config leaves nothing of the previous one
+	cfg.KeyringAccNames = nil
+	require.NoError(t, cfg.Save(dir))
+	loaded, err := Load(dir)
+	require.NoError(t, err)
+	assert.Equal(t, cfg, loaded)
+
+	info, err := os.Stat(ConfigPath(dir))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0640), info.Mode().Perm())
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
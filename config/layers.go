@@ -0,0 +1,235 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	// EnvPrefix prefixes the environment variables overriding the config,
+	// such as DALC_BLOCK_SUBMITTER_GAS_LIMIT for block-submitter.gas-limit
+	EnvPrefix = "DALC_"
+	// FlagPrefix prefixes the flags overriding the config, such as
+	// --dalc.block-submitter.gas-limit
+	FlagPrefix = "dalc."
+
+	// SourceDefault is the source of values that were not set
+	SourceDefault = "default"
+	// SourceFile is the source of values set in optimint_server.toml
+	SourceFile = "file"
+)
+
+// Setting is the effective value of a field of the config along with the layer
+// it was set by: SourceDefault, SourceFile, the environment variable or the
+// flag
+type Setting struct {
+	Key    string
+	Value  string
+	Source string
+}
+
+// field is a field of the config, with its key as written in the TOML file
+type field struct {
+	section string
+	name    string
+	value   reflect.Value
+}
+
+func (f field) key() string {
+	return f.section + "." + f.name
+}
+
+func (f field) envName() string {
+	return EnvPrefix + strings.ToUpper(strings.NewReplacer("-", "_", ".", "_").Replace(f.key()))
+}
+
+func (f field) flagName() string {
+	return FlagPrefix + f.key()
+}
+
+// fields returns the fields of every section of the config in the order they
+// are declared. The version of the config, which is not part of a section, is
+// left out
+func fields(cfg *ServerConfig) []field {
+	var fs []field
+	sections := reflect.ValueOf(cfg).Elem()
+	for i := 0; i < sections.NumField(); i++ {
+		section := sections.Type().Field(i).Tag.Get("toml")
+		values := sections.Field(i)
+		if values.Kind() != reflect.Struct {
+			continue
+		}
+		for j := 0; j < values.NumField(); j++ {
+			name := values.Type().Field(j).Tag.Get("toml")
+			if name == "" || name == "-" {
+				continue
+			}
+			fs = append(fs, field{section: section, name: name, value: values.Field(j)})
+		}
+	}
+	return fs
+}
+
+// set parses raw according to the type of the field and sets it
+func (f field) set(raw string) error {
+	v, err := parseValue(f.value.Type(), raw)
+	if err != nil {
+		return err
+	}
+	f.value.Set(v)
+	return nil
+}
+
+// String formats the value of the field the way it is parsed by set
+func (f field) String() string {
+	switch v := f.value.Interface().(type) {
+	case []string:
+		return strings.Join(v, ",")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+func parseValue(typ reflect.Type, raw string) (reflect.Value, error) {
+	v := reflect.New(typ).Elem()
+	switch {
+	case typ == durationType:
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return v, err
+		}
+		v.SetInt(int64(d))
+	case typ.Kind() == reflect.String:
+		v.SetString(raw)
+	case typ.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return v, err
+		}
+		v.SetBool(b)
+	case typ.Kind() == reflect.Int:
+		i, err := strconv.ParseInt(raw, 10, 0)
+		if err != nil {
+			return v, err
+		}
+		v.SetInt(i)
+	case typ.Kind() == reflect.Uint64:
+		u, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return v, err
+		}
+		v.SetUint(u)
+	case typ.Kind() == reflect.Float64:
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return v, err
+		}
+		v.SetFloat(f)
+	case typ.Kind() == reflect.Slice && typ.Elem().Kind() == reflect.String:
+		var items []string
+		for _, item := range strings.Split(raw, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		v.Set(reflect.ValueOf(items))
+	default:
+		return v, fmt.Errorf("unsupported type %s", typ)
+	}
+	return v, nil
+}
+
+// flagValue is a pflag.Value holding the raw value of a flag overriding a
+// field of the config, which is checked when the flag is parsed
+type flagValue struct {
+	typ reflect.Type
+	raw string
+}
+
+func (fv *flagValue) String() string {
+	return fv.raw
+}
+
+func (fv *flagValue) Set(raw string) error {
+	_, err := parseValue(fv.typ, raw)
+	if err != nil {
+		return err
+	}
+	fv.raw = raw
+	return nil
+}
+
+func (fv *flagValue) Type() string {
+	switch {
+	case fv.typ == durationType:
+		return "duration"
+	case fv.typ.Kind() == reflect.Slice:
+		return "strings"
+	default:
+		return fv.typ.Kind().String()
+	}
+}
+
+// Flags returns a flag for each field of the config, named after its key with
+// FlagPrefix, which overrides the field when set. They are meant to be added
+// to the command starting the dalc and passed to LoadEffective.
+func Flags() *pflag.FlagSet {
+	flags := pflag.NewFlagSet("dalc", pflag.ContinueOnError)
+	var cfg ServerConfig
+	for _, f := range fields(&cfg) {
+		flag := flags.VarPF(&flagValue{typ: f.value.Type()}, f.flagName(), "",
+			fmt.Sprintf("overrides %s of the dalc config, also set by $%s", f.key(), f.envName()))
+		if f.value.Kind() == reflect.Bool {
+			flag.NoOptDefVal = "true"
+		}
+	}
+	return flags
+}
+
+// LoadEffective loads the config found in path, layering the defaults, the
+// config file, the DALC_* environment variables and the flags returned by
+// Flags, each overriding the previous ones. Flags that are not part of flags
+// are ignored, so flags can be nil. The settings list every field along with
+// the layer that set it.
+func LoadEffective(path string, flags *pflag.FlagSet) (ServerConfig, []Setting, error) {
+	cf, err := readFile(path)
+	cfg, md := cf.cfg, cf.md
+	if err != nil {
+		return cfg, nil, err
+	}
+
+	fs := fields(&cfg)
+	settings := make([]Setting, len(fs))
+	for i, f := range fs {
+		source := SourceDefault
+		if md.IsDefined(f.section, f.name) {
+			source = SourceFile
+		}
+		if raw, ok := os.LookupEnv(f.envName()); ok {
+			err = f.set(raw)
+			if err != nil {
+				return cfg, nil, fmt.Errorf("$%s: %w", f.envName(), err)
+			}
+			source = "$" + f.envName()
+		}
+		if flags != nil {
+			if flag := flags.Lookup(f.flagName()); flag != nil && flag.Changed {
+				err = f.set(flag.Value.String())
+				if err != nil {
+					return cfg, nil, fmt.Errorf("--%s: %w", f.flagName(), err)
+				}
+				source = "--" + f.flagName()
+			}
+		}
+		settings[i] = Setting{Key: f.key(), Value: f.String(), Source: source}
+	}
+	return cfg, settings, nil
+}
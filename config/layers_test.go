@@ -0,0 +1,58 @@
+package config
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadEffective(t *testing.T) {
+	dir := t.TempDir()
+	raw := "[block-submitter]\ngas-limit = 100\nfee-amount = 5\ndenomination = \"utia\"\n"
+	require.NoError(t, os.WriteFile(ConfigPath(dir), []byte(raw), 0600))
+
+	t.Setenv("DALC_BLOCK_SUBMITTER_FEE_AMOUNT", "7")
+	t.Setenv("DALC_BLOCK_SUBMITTER_GAS_LIMIT", "200")
+	t.Setenv("DALC_BLOCK_SUBMITTER_KEYRING_ACCOUNT_NAMES", "a, b")
+	flags := Flags()
+	require.NoError(t, flags.Parse([]string{
+		"--dalc.block-submitter.gas-limit=300",
+		"--dalc.metrics.enabled",
+		"--dalc.health.max-head-age=1m",
+	}))
+
+	cfg, settings, err := LoadEffective(dir, flags)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(300), cfg.GasLimit)
+	assert.Equal(t, uint64(7), cfg.FeeAmount)
+	assert.Equal(t, "utia", cfg.Denom)
+	assert.Equal(t, []string{"a", "b"}, cfg.KeyringAccNames)
+	assert.True(t, cfg.MetricsConfig.Enabled)
+	assert.Equal(t, time.Minute, cfg.MaxHeadAge)
+	assert.Equal(t, DefaultBaseConfig(), cfg.BaseConfig)
+
+	sources := make(map[string]string)
+	for _, s := range settings {
+		sources[s.Key] = s.Source
+	}
+	assert.Equal(t, "--dalc.block-submitter.gas-limit", sources["block-submitter.gas-limit"])
+	assert.Equal(t, "$DALC_BLOCK_SUBMITTER_FEE_AMOUNT", sources["block-submitter.fee-amount"])
+	assert.Equal(t, SourceFile, sources["block-submitter.denomination"])
+	assert.Equal(t, SourceDefault, sources["base.laddr"])
+	assert.Len(t, settings, len(fields(&cfg)))
+}
+
+func TestLoadEffectiveInvalidValues(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, DefaultServerConfig(dir).Save(dir))
+
+	t.Setenv("DALC_BLOCK_SUBMITTER_GAS_LIMIT", "lots")
+	_, _, err := LoadEffective(dir, nil)
+	assert.ErrorContains(t, err, "DALC_BLOCK_SUBMITTER_GAS_LIMIT")
+
+	// flags are checked when parsed
+	assert.Error(t, Flags().Parse([]string{"--dalc.health.check-interval=soon"}))
+}
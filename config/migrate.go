@@ -0,0 +1,225 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// Version is the version of the config written by this version of the dalc. It
+// is increased by the changes to the fields of the config that existing files
+// must be migrated for, such as renaming a field, along with the migration
+// from the previous version.
+const Version = 1
+
+// migration rewrites the raw TOML of a config of one version so that it
+// matches the fields of the next version
+type migration func(raw map[string]interface{}) error
+
+// migrations are indexed by the version they migrate from
+var migrations = []migration{
+	// version 0 configs predate the version field, their fields are the ones
+	// of version 1
+	0: func(raw map[string]interface{}) error { return nil },
+}
+
+// migrate applies the migrations upgrading raw to Version and returns the
+// version raw was written with
+func migrate(raw map[string]interface{}) (int, error) {
+	var from int
+	if v, ok := raw["version"]; ok {
+		i, ok := v.(int64)
+		if !ok || i < 0 {
+			return 0, fmt.Errorf("version must be a positive integer, got %v", v)
+		}
+		if i > Version {
+			return 0, fmt.Errorf("version %d was written by a newer dalc, this one supports up to version %d", i, Version)
+		}
+		from = int(i)
+	}
+	for v := from; v < Version; v++ {
+		err := migrations[v](raw)
+		if err != nil {
+			return from, fmt.Errorf("migrating from version %d: %w", v, err)
+		}
+	}
+	raw["version"] = int64(Version)
+	return from, nil
+}
+
+// configFile is a config file decoded on top of the default config
+type configFile struct {
+	// raw is the content of the file, as written
+	raw []byte
+	// from is the version the file was written with
+	from int
+	cfg  ServerConfig
+	md   toml.MetaData
+}
+
+// readFile reads the config file found in path, migrating it to Version, and
+// decodes it on top of the default config. The keys that do not match a field
+// of the config are rejected, as they are likely typos.
+func readFile(path string) (configFile, error) {
+	cf := configFile{cfg: DefaultServerConfig(path)}
+	path = ConfigPath(path)
+	var err error
+	cf.raw, err = os.ReadFile(path)
+	if err != nil {
+		return cf, err
+	}
+
+	data := string(cf.raw)
+	raw := make(map[string]interface{})
+	_, err = toml.Decode(data, &raw)
+	if err != nil {
+		return cf, fmt.Errorf("%s: %w", path, err)
+	}
+	cf.from, err = migrate(raw)
+	if err != nil {
+		return cf, fmt.Errorf("%s: %w", path, err)
+	}
+	// the file is decoded as written if it is up to date, so that errors
+	// point at its lines
+	if cf.from != Version {
+		data, err = encode(raw)
+		if err != nil {
+			return cf, err
+		}
+	}
+
+	cf.md, err = toml.Decode(data, &cf.cfg)
+	if err != nil {
+		return cf, fmt.Errorf("%s: %w", path, err)
+	}
+	if undecoded := cf.md.Undecoded(); len(undecoded) > 0 {
+		keys := make([]string, len(undecoded))
+		for i, key := range undecoded {
+			keys[i] = key.String()
+		}
+		return cf, fmt.Errorf("%s: unknown keys %s", path, strings.Join(keys, ", "))
+	}
+	return cf, nil
+}
+
+func encode(v interface{}) (string, error) {
+	var buf bytes.Buffer
+	err := toml.NewEncoder(&buf).Encode(v)
+	return buf.String(), err
+}
+
+// Migration rewrites a config file written by an older version of the dalc
+// so that it sets every field of the current version
+type Migration struct {
+	// From is the version the file was written with
+	From int
+	// Added are the fields the file did not set, which take their default
+	// value
+	Added []Setting
+	// Config is the migrated config
+	Config ServerConfig
+
+	raw []byte
+}
+
+// PlanMigration returns the migration of the config file found in path,
+// without applying it
+func PlanMigration(path string) (Migration, error) {
+	cf, err := readFile(path)
+	if err != nil {
+		return Migration{}, err
+	}
+
+	m := Migration{From: cf.from, Config: cf.cfg, raw: cf.raw}
+	for _, f := range fields(&cf.cfg) {
+		// empty lists are not written to the file
+		if f.value.Kind() == reflect.Slice && f.value.Len() == 0 {
+			continue
+		}
+		if !cf.md.IsDefined(f.section, f.name) {
+			m.Added = append(m.Added, Setting{Key: f.key(), Value: f.String(), Source: SourceDefault})
+		}
+	}
+	return m, nil
+}
+
+// Outdated returns true if the file was written with an older version
+func (m Migration) Outdated() bool {
+	return m.From < Version
+}
+
+// Needed returns true if applying the migration changes the file
+func (m Migration) Needed() bool {
+	return m.Outdated() || len(m.Added) > 0
+}
+
+// Diff returns a unified diff of the changes made to the keys of the file by
+// the migration. Both versions are formatted the same way, so that only the
+// changed keys and values show up.
+func (m Migration) Diff() (string, error) {
+	before := make(map[string]interface{})
+	_, err := toml.Decode(string(m.raw), &before)
+	if err != nil {
+		return "", err
+	}
+	migrated, err := encode(m.Config)
+	if err != nil {
+		return "", err
+	}
+	after := make(map[string]interface{})
+	_, err = toml.Decode(migrated, &after)
+	if err != nil {
+		return "", err
+	}
+
+	a, err := encode(before)
+	if err != nil {
+		return "", err
+	}
+	b, err := encode(after)
+	if err != nil {
+		return "", err
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(a),
+		B:        difflib.SplitLines(b),
+		FromFile: fmt.Sprintf("%s (version %d)", ConfigFileName, m.From),
+		ToFile:   fmt.Sprintf("%s (version %d)", ConfigFileName, Version),
+		Context:  3,
+	})
+}
+
+// Apply saves the migrated config in path after backing up the file, whose
+// backup path is returned
+func (m Migration) Apply(path string) (string, error) {
+	cfgPath := ConfigPath(path)
+	perm := os.FileMode(0600)
+	if info, err := os.Stat(cfgPath); err == nil {
+		perm = info.Mode().Perm()
+	}
+	backup := fmt.Sprintf("%s.v%d.%s.bak", cfgPath, m.From, time.Now().UTC().Format("20060102T150405Z"))
+	err := os.WriteFile(backup, m.raw, perm)
+	if err != nil {
+		return "", err
+	}
+	return backup, m.Config.Save(path)
+}
+
+// Migrate migrates the config file found in path if it was written with an
+// older version of the dalc or lacks fields, the fields it does not set taking
+// their default value while the values it sets are kept. The file is backed up
+// first.
+func Migrate(path string) (Migration, string, error) {
+	m, err := PlanMigration(path)
+	if err != nil || !m.Needed() {
+		return m, "", err
+	}
+	backup, err := m.Apply(path)
+	return m, backup, err
+}
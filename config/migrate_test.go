@@ -0,0 +1,67 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrationsCoverEveryVersion(t *testing.T) {
+	assert.Len(t, migrations, Version)
+}
+
+func TestMigrate(t *testing.T) {
+	dir := t.TempDir()
+	// a config written before the version field
+	raw := "[base]\nladdr = \"127.0.0.1:5000\"\n\n[block-submitter]\ngas-limit = 100\n"
+	require.NoError(t, os.WriteFile(ConfigPath(dir), []byte(raw), 0600))
+
+	// old configs are migrated in memory when loaded
+	cfg, err := Load(dir)
+	require.NoError(t, err)
+	assert.Equal(t, Version, cfg.Version)
+	assert.NoError(t, cfg.Validate())
+
+	m, err := PlanMigration(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 0, m.From)
+	assert.True(t, m.Outdated())
+	assert.Contains(t, m.Added, Setting{Key: "block-submitter.fee-amount", Value: "1", Source: SourceDefault})
+	assert.NotContains(t, m.Added, Setting{Key: "block-submitter.gas-limit", Value: "100", Source: SourceDefault})
+
+	diff, err := m.Diff()
+	require.NoError(t, err)
+	assert.Contains(t, diff, "+version = 1")
+	assert.Contains(t, diff, "+  fee-amount = 1")
+	assert.Contains(t, diff, "   gas-limit = 100")
+	assert.NotContains(t, diff, "-  gas-limit")
+
+	m, backup, err := Migrate(dir)
+	require.NoError(t, err)
+	assert.True(t, m.Needed())
+	backedUp, err := os.ReadFile(backup)
+	require.NoError(t, err)
+	assert.Equal(t, raw, string(backedUp))
+	assert.Equal(t, dir, filepath.Dir(backup))
+
+	cfg, err = Load(dir)
+	require.NoError(t, err)
+	assert.Equal(t, "127.0.0.1:5000", cfg.ListenAddr)
+	assert.Equal(t, uint64(100), cfg.GasLimit)
+
+	// migrating again has nothing to change
+	m, backup, err = Migrate(dir)
+	require.NoError(t, err)
+	assert.False(t, m.Needed())
+	assert.Empty(t, backup)
+}
+
+func TestLoadNewerVersion(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(ConfigPath(dir), []byte("version = 99\n"), 0600))
+	_, err := Load(dir)
+	assert.ErrorContains(t, err, "written by a newer dalc")
+}
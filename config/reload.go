@@ -0,0 +1,31 @@
+package config
+
+import "reflect"
+
+// reloadable are the keys of the fields applied by a running dalc when its
+// config is reloaded. The other fields are only applied on start.
+var reloadable = map[string]bool{
+	"base.log-level":             true,
+	"block-submitter.fee-amount": true,
+	"block-submitter.gas-limit":  true,
+	"balance.low-threshold":      true,
+}
+
+// RestartRequired returns the keys of the fields that differ between cfg and
+// next and are only applied when the dalc starts
+func (cfg ServerConfig) RestartRequired(next ServerConfig) []string {
+	var keys []string
+	nextFields := fields(&next)
+	for i, f := range fields(&cfg) {
+		if reloadable[f.key()] {
+			continue
+		}
+		if !reflect.DeepEqual(f.value.Interface(), nextFields[i].value.Interface()) {
+			keys = append(keys, f.key())
+		}
+	}
+	if cfg.Version != next.Version {
+		keys = append(keys, "version")
+	}
+	return keys
+}
@@ -0,0 +1,21 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestartRequired(t *testing.T) {
+	cfg := DefaultServerConfig("/tmp")
+	next := cfg
+	next.FeeAmount = 10
+	next.GasLimit = 10
+	next.LogLevel = "debug"
+	next.BalanceConfig.LowThreshold = 10
+	assert.Empty(t, cfg.RestartRequired(next))
+
+	next.ChainID = "devnet"
+	next.KeyringAccNames = []string{"a", "b"}
+	assert.Equal(t, []string{"block-submitter.chain-id", "block-submitter.keyring-account-names"}, cfg.RestartRequired(next))
+}
@@ -0,0 +1,266 @@
+package config
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	logging "github.com/ipfs/go-log/v2"
+)
+
+// NamespaceSize is the size in bytes of the namespace blocks are submitted to
+const NamespaceSize = 8
+
+var (
+	// keyringBackends are the backends supported by the cosmos-sdk keyring
+	keyringBackends = []string{"os", "file", "kwallet", "pass", "test", "memory"}
+	// tracingExporters are the exporters supported by the tracing package
+	tracingExporters = []string{"", "otlp-grpc", "otlp-http", "stdout"}
+	// denomRegex matches the denominations accepted by the cosmos-sdk
+	denomRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9/:._-]{2,127}$`)
+)
+
+// FieldError is a problem with the value of a field of the config. Field is
+// the name of the field as written in the TOML file, such as "base.namespace"
+type FieldError struct {
+	Field string
+	Msg   string
+}
+
+func (e FieldError) Error() string {
+	return e.Field + ": " + e.Msg
+}
+
+// ValidationError holds every problem found while validating a config
+type ValidationError []FieldError
+
+func (e ValidationError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "invalid config, %d problem(s):", len(e))
+	for _, fe := range e {
+		b.WriteString("\n  ")
+		b.WriteString(fe.Error())
+	}
+	return b.String()
+}
+
+// validator collects the problems found with the fields of a config
+type validator struct {
+	errs ValidationError
+}
+
+func (v *validator) addf(field, format string, args ...interface{}) {
+	v.errs = append(v.errs, FieldError{Field: field, Msg: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) hostPort(field, addr string) {
+	if addr == "" {
+		v.addf(field, "must be set to a host:port address")
+		return
+	}
+	_, port, err := net.SplitHostPort(addr)
+	if err != nil || port == "" {
+		v.addf(field, "%q is not a host:port address", addr)
+	}
+}
+
+func (v *validator) httpURL(field, addr string) {
+	u, err := url.Parse(addr)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		v.addf(field, "%q is not an http or https URL", addr)
+	}
+}
+
+// loopback returns true if host names the local machine
+func loopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func (v *validator) nonNegative(field string, d time.Duration) {
+	if d < 0 {
+		v.addf(field, "must not be negative, got %v", d)
+	}
+}
+
+func (v *validator) rate(field string, r float64) {
+	if r < 0 || r > 1 {
+		v.addf(field, "must be between 0 and 1, got %v", r)
+	}
+}
+
+func (v *validator) oneOf(field, value string, allowed []string) {
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+	var quoted []string
+	for _, a := range allowed {
+		if a != "" {
+			quoted = append(quoted, fmt.Sprintf("%q", a))
+		}
+	}
+	v.addf(field, "unknown value %q, use one of %s", value, strings.Join(quoted, ", "))
+}
+
+// Validate checks every field of the config, returning a ValidationError
+// listing all the problems found or nil if there are none
+func (cfg ServerConfig) Validate() error {
+	v := &validator{}
+
+	if cfg.Version != Version {
+		v.addf("version", "must be %d, migrate the config with config migrate", Version)
+	}
+
+	// base
+	v.hostPort("base.laddr", cfg.ListenAddr)
+	namespace, err := hex.DecodeString(cfg.Namespace)
+	switch {
+	case err != nil:
+		v.addf("base.namespace", "%q is not hex encoded", cfg.Namespace)
+	case len(namespace) != NamespaceSize:
+		v.addf("base.namespace", "must be %d bytes (%d hex characters), got %d bytes", NamespaceSize, NamespaceSize*2, len(namespace))
+	}
+	if cfg.NodeRPCAddress != "" {
+		v.httpURL("base.celestia-node-rpc-addr", cfg.NodeRPCAddress)
+	}
+	v.nonNegative("base.shutdown-timeout", cfg.ShutdownTimeout)
+	if cfg.LogLevel != "" {
+		if _, err := logging.LevelFromString(cfg.LogLevel); err != nil {
+			v.addf("base.log-level", "unknown level %q, use debug, info, warn or error", cfg.LogLevel)
+		}
+	}
+
+	// block-submitter
+	if cfg.GasLimit == 0 {
+		v.addf("block-submitter.gas-limit", "must be positive")
+	}
+	if !denomRegex.MatchString(cfg.Denom) {
+		v.addf("block-submitter.denomination", "%q is not a valid denomination", cfg.Denom)
+	}
+	v.hostPort("block-submitter.celestia-grpc-addr", cfg.GRPCAddress)
+	if cfg.RestRPCAddress != "" {
+		v.hostPort("block-submitter.celestia-rest-addr", cfg.RestRPCAddress)
+	}
+	if cfg.ChainID == "" {
+		v.addf("block-submitter.chain-id", "must be set")
+	}
+	v.nonNegative("block-submitter.timeout", cfg.Timeout)
+	if cfg.BroadcastMode < 1 || cfg.BroadcastMode > 3 {
+		v.addf("block-submitter.broadcast-mode", "must be 1 (block), 2 (sync) or 3 (async), got %d", cfg.BroadcastMode)
+	}
+	if len(cfg.KeyringAccNames) == 0 && cfg.KeyringAccName == "" {
+		v.addf("block-submitter.keyring-account-name", "must be set unless keyring-account-names is")
+	}
+	seen := make(map[string]bool)
+	for _, name := range cfg.KeyringAccNames {
+		switch {
+		case name == "":
+			v.addf("block-submitter.keyring-account-names", "must not contain empty names")
+		case seen[name]:
+			v.addf("block-submitter.keyring-account-names", "%q is listed more than once", name)
+		}
+		seen[name] = true
+	}
+
+	// keyring, which is not used when signing remotely
+	if cfg.SignerConfig.RemoteAddress == "" {
+		v.oneOf("keyring.backend", cfg.KeyringBackend, keyringBackends)
+		if cfg.KeyringPath == "" && cfg.KeyringBackend != "memory" {
+			v.addf("keyring.path", "must be set")
+		}
+		if cfg.InsecureBackend() && cfg.ChainID != "test" && !cfg.AllowInsecureBackend {
+			v.addf("keyring.backend", "the %s backend stores keys unencrypted, use the file or os backend on chain %s "+
+				"or set keyring.allow-insecure-backend", cfg.KeyringBackend, cfg.ChainID)
+		}
+	}
+
+	// chaos
+	v.nonNegative("chaos.delay", cfg.ChaosConfig.Delay)
+	v.rate("chaos.error-rate", cfg.ErrorRate)
+	v.rate("chaos.timeout-rate", cfg.TimeoutRate)
+	v.rate("chaos.drop-rate", cfg.DropRate)
+	v.rate("chaos.reorder-rate", cfg.ReorderRate)
+	v.rate("chaos.duplicate-rate", cfg.DuplicateRate)
+	v.rate("chaos.false-availability-rate", cfg.FalseAvailabilityRate)
+
+	// tls
+	if cfg.TLSConfig.CertFile != "" && cfg.TLSConfig.KeyFile == "" {
+		v.addf("tls.key-file", "must be set along with tls.cert-file")
+	}
+	if cfg.TLSConfig.CertFile == "" && cfg.TLSConfig.KeyFile != "" {
+		v.addf("tls.cert-file", "must be set along with tls.key-file")
+	}
+	if cfg.ClientCAFile != "" && cfg.TLSConfig.CertFile == "" {
+		v.addf("tls.client-ca-file", "requires tls.cert-file, mutual TLS is only served over TLS")
+	}
+
+	// celestia-app-conn
+	if (cfg.AppConnConfig.CertFile == "") != (cfg.AppConnConfig.KeyFile == "") {
+		v.addf("celestia-app-conn.cert-file", "must be set along with celestia-app-conn.key-file")
+	}
+	v.nonNegative("celestia-app-conn.keepalive-time", cfg.KeepaliveTime)
+	v.nonNegative("celestia-app-conn.keepalive-timeout", cfg.KeepaliveTimeout)
+	v.nonNegative("celestia-app-conn.reconnect-base-delay", cfg.ReconnectBaseDelay)
+	v.nonNegative("celestia-app-conn.reconnect-max-delay", cfg.ReconnectMaxDelay)
+	if cfg.ReconnectMaxDelay > 0 && cfg.ReconnectMaxDelay < cfg.ReconnectBaseDelay {
+		v.addf("celestia-app-conn.reconnect-max-delay", "must not be lower than reconnect-base-delay (%v), got %v",
+			cfg.ReconnectBaseDelay, cfg.ReconnectMaxDelay)
+	}
+	v.nonNegative("celestia-app-conn.min-connect-timeout", cfg.MinConnectTimeout)
+
+	// metrics
+	if cfg.MetricsConfig.Enabled {
+		v.hostPort("metrics.laddr", cfg.MetricsConfig.Address)
+	}
+
+	// tracing
+	v.oneOf("tracing.exporter", cfg.Exporter, tracingExporters)
+	if cfg.Exporter == "otlp-grpc" || cfg.Exporter == "otlp-http" {
+		v.hostPort("tracing.endpoint", cfg.Endpoint)
+	}
+	v.rate("tracing.sample-rate", cfg.SampleRate)
+	if cfg.Exporter != "" && cfg.ServiceName == "" {
+		v.addf("tracing.service-name", "must be set when tracing is enabled")
+	}
+
+	// health
+	v.nonNegative("health.check-interval", cfg.HealthConfig.CheckInterval)
+	// zero would report every header as out of sync
+	if cfg.MaxHeadAge <= 0 {
+		v.addf("health.max-head-age", "must be positive, got %v", cfg.MaxHeadAge)
+	}
+
+	// gateway
+	if cfg.GatewayConfig.Enabled {
+		v.hostPort("gateway.laddr", cfg.GatewayConfig.Address)
+	}
+
+	// balance
+	v.nonNegative("balance.check-interval", cfg.BalanceConfig.CheckInterval)
+
+	// signer
+	if cfg.SignerConfig.RemoteAddress != "" {
+		v.httpURL("signer.remote-addr", cfg.SignerConfig.RemoteAddress)
+		// the token would be readable by anyone on the path to the daemon
+		u, err := url.Parse(cfg.SignerConfig.RemoteAddress)
+		plaintext := err == nil && u.Scheme == "http" && !loopback(u.Hostname())
+		if plaintext && cfg.RemoteToken != "" && !cfg.SignerConfig.Insecure {
+			v.addf("signer.remote-addr", "%q would receive signer.remote-token without TLS, use https or set signer.insecure", cfg.SignerConfig.RemoteAddress)
+		}
+	}
+	v.nonNegative("signer.remote-timeout", cfg.RemoteTimeout)
+
+	if len(v.errs) > 0 {
+		return v.errs
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package mock
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	heightFileName = "height"
+	blockFileExt   = ".block"
+)
+
+// NewFileStore opens a Store that persists blocks in the provided directory,
+// so that they survive restarts. Blocks are written to
+// <dir>/<namespace>/<height>/<index>.block, and the latest DA height is kept in
+// <dir>/height.
+func NewFileStore(dir string) (Store, error) {
+	err := os.MkdirAll(dir, 0750)
+	if err != nil {
+		return nil, err
+	}
+
+	fs := &fileStore{dir: dir}
+
+	rawHeight, err := os.ReadFile(filepath.Join(dir, heightFileName))
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return nil, err
+	default:
+		fs.height, err = strconv.ParseUint(strings.TrimSpace(string(rawHeight)), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid stored height: %w", err)
+		}
+	}
+
+	return fs, nil
+}
+
+type fileStore struct {
+	dir string
+
+	mtx    sync.RWMutex
+	height uint64
+}
+
+func (fs *fileStore) Put(height uint64, namespace, block []byte) error {
+	fs.mtx.Lock()
+	defer fs.mtx.Unlock()
+
+	dir := fs.blockDir(height, namespace)
+	err := os.MkdirAll(dir, 0750)
+	if err != nil {
+		return err
+	}
+
+	names, err := fs.blockFiles(dir)
+	if err != nil {
+		return err
+	}
+
+	return writeFile(filepath.Join(dir, fmt.Sprintf("%d%s", len(names), blockFileExt)), block)
+}
+
+func (fs *fileStore) Get(height uint64, namespace []byte) ([][]byte, error) {
+	fs.mtx.RLock()
+	defer fs.mtx.RUnlock()
+
+	dir := fs.blockDir(height, namespace)
+	names, err := fs.blockFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make([][]byte, len(names))
+	for i, name := range names {
+		blocks[i], err = os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return blocks, nil
+}
+
+func (fs *fileStore) Height() uint64 {
+	fs.mtx.RLock()
+	defer fs.mtx.RUnlock()
+	return fs.height
+}
+
+func (fs *fileStore) SetHeight(height uint64) error {
+	fs.mtx.Lock()
+	defer fs.mtx.Unlock()
+	err := writeFile(filepath.Join(fs.dir, heightFileName), []byte(strconv.FormatUint(height, 10)))
+	if err != nil {
+		return err
+	}
+	fs.height = height
+	return nil
+}
+
+func (fs *fileStore) blockDir(height uint64, namespace []byte) string {
+	return filepath.Join(fs.dir, hex.EncodeToString(namespace), strconv.FormatUint(height, 10))
+}
+
+// blockFiles returns the names of the block files in dir in order of
+// submission
+func (fs *fileStore) blockFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	indexes := make([]int, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, blockFileExt) {
+			continue
+		}
+		index, err := strconv.Atoi(strings.TrimSuffix(name, blockFileExt))
+		if err != nil {
+			continue
+		}
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+
+	names := make([]string, len(indexes))
+	for i, index := range indexes {
+		names[i] = fmt.Sprintf("%d%s", index, blockFileExt)
+	}
+	return names, nil
+}
+
+// writeFile writes data to a temporary file before moving it to path, so that
+// a crash never leaves a partially written file behind
+func writeFile(path string, data []byte) error {
+	tmpPath := path + ".tmp"
+	err := os.WriteFile(tmpPath, data, 0640)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
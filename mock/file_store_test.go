@@ -0,0 +1,70 @@
+package mock
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/dalc/proto/dalc"
+)
+
+func TestFileStorePersistence(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	namespace := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+
+	store, err := NewFileStore(dir)
+	require.NoError(t, err)
+	lc := New(namespace, 0, WithStore(store))
+
+	blocks := []*dalc.SubmitBlockRequest{
+		{Block: testBlock(1, namespace)},
+		{Block: testBlock(2, namespace)},
+	}
+	for _, req := range blocks {
+		_, err = lc.SubmitBlock(ctx, req)
+		require.NoError(t, err)
+	}
+
+	// reopen the store to simulate a restart
+	store, err = NewFileStore(dir)
+	require.NoError(t, err)
+	lc = New(namespace, 0, WithStore(store))
+	assert.Equal(t, uint64(2), lc.Height())
+
+	for i, req := range blocks {
+		resp, err := lc.RetrieveBlocks(ctx, &dalc.RetrieveBlocksRequest{DataLayerHeight: uint64(i + 1)})
+		require.NoError(t, err)
+		require.Len(t, resp.Blocks, 1)
+		assert.Equal(t, *req.Block, *resp.Blocks[0])
+	}
+
+	// new submissions continue from the stored height
+	resp, err := lc.SubmitBlock(ctx, &dalc.SubmitBlockRequest{Block: testBlock(3, namespace)})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), resp.Result.DataLayerHeight)
+}
+
+func TestFileStoreOrdering(t *testing.T) {
+	store, err := NewFileStore(t.TempDir())
+	require.NoError(t, err)
+	namespace := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+
+	// more than ten entries to ensure ordering is not lexicographic
+	var expected [][]byte
+	for i := 0; i < 12; i++ {
+		block := []byte{byte(i)}
+		require.NoError(t, store.Put(1, namespace, block))
+		expected = append(expected, block)
+	}
+
+	got, err := store.Get(1, namespace)
+	require.NoError(t, err)
+	assert.Equal(t, expected, got)
+
+	got, err = store.Get(1, []byte{8, 7, 6, 5, 4, 3, 2, 1})
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
@@ -0,0 +1,308 @@
+package mock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	logging "github.com/ipfs/go-log/v2"
+
+	"github.com/celestiaorg/dalc/proto/dalc"
+	"github.com/celestiaorg/dalc/proto/optimint"
+)
+
+var log = logging.Logger("dalc/mock")
+
+// ErrUnavailable is returned when blocks are requested from a height that is
+// configured to be unavailable
+var ErrUnavailable = errors.New("data is unavailable")
+
+// DataAvailabilityLightClient is an implementation of the DALCService meant for
+// local rollup development. Blocks are kept in a Store and are indexed by a
+// simulated data availability height, which is increased every BlockTime. If
+// BlockTime is zero, the height is increased once per submitted block instead.
+type DataAvailabilityLightClient struct {
+	namespace []byte
+	blockTime time.Duration
+
+	store              Store
+	delay              time.Duration
+	unavailableHeights map[uint64]struct{}
+
+	// mtx guards the height along with the writes to the store, so that
+	// blocks are always stored at the current height
+	mtx    sync.RWMutex
+	height uint64
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// Option configures optional behavior of the mock DataAvailabilityLightClient
+type Option func(*DataAvailabilityLightClient)
+
+// WithStore sets the Store used to keep submitted blocks. Defaults to a Store
+// that only keeps blocks in memory.
+func WithStore(store Store) Option {
+	return func(d *DataAvailabilityLightClient) {
+		d.store = store
+	}
+}
+
+// WithDelay delays every response by the provided duration
+func WithDelay(delay time.Duration) Option {
+	return func(d *DataAvailabilityLightClient) {
+		d.delay = delay
+	}
+}
+
+// WithUnavailableHeights causes the data posted at the provided heights to be
+// reported as unavailable
+func WithUnavailableHeights(heights ...uint64) Option {
+	return func(d *DataAvailabilityLightClient) {
+		for _, height := range heights {
+			d.unavailableHeights[height] = struct{}{}
+		}
+	}
+}
+
+// New creates a new mock DataAvailabilityLightClient that serves the blocks of
+// the provided namespace
+func New(namespace []byte, blockTime time.Duration, opts ...Option) *DataAvailabilityLightClient {
+	d := &DataAvailabilityLightClient{
+		namespace:          namespace,
+		blockTime:          blockTime,
+		store:              NewMemStore(),
+		unavailableHeights: make(map[uint64]struct{}),
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	d.height = d.store.Height()
+	// when producing blocks on a timer, submissions are included in the
+	// current height, so start at the first one
+	if blockTime > 0 && d.height == 0 {
+		d.height = 1
+	}
+	return d
+}
+
+// Start begins producing simulated DA blocks every BlockTime
+func (d *DataAvailabilityLightClient) Start(ctx context.Context) error {
+	if d.blockTime == 0 || d.done != nil {
+		return nil
+	}
+	// the provided context only bounds startup, so block production is tied
+	// to Stop instead
+	runCtx, cancel := context.WithCancel(context.Background())
+	d.cancel = cancel
+	d.done = make(chan struct{})
+	go d.produceBlocks(runCtx)
+	return nil
+}
+
+// Stop halts the production of simulated DA blocks
+func (d *DataAvailabilityLightClient) Stop(ctx context.Context) error {
+	if d.done == nil {
+		return nil
+	}
+	d.cancel()
+	select {
+	case <-d.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (d *DataAvailabilityLightClient) produceBlocks(ctx context.Context) {
+	defer close(d.done)
+	ticker := time.NewTicker(d.blockTime)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			d.mtx.Lock()
+			err := d.store.SetHeight(d.height + 1)
+			if err != nil {
+				log.Errorw("failed to store mock DA height", "height", d.height+1, "err", err)
+			} else {
+				d.height++
+				log.Debugw("produced mock DA block", "height", d.height)
+			}
+			d.mtx.Unlock()
+		}
+	}
+}
+
+// Height returns the latest simulated DA height
+func (d *DataAvailabilityLightClient) Height() uint64 {
+	d.mtx.RLock()
+	defer d.mtx.RUnlock()
+	return d.height
+}
+
+// SubmitBlock stores an optimint block at the current simulated DA height
+func (d *DataAvailabilityLightClient) SubmitBlock(ctx context.Context, blockReq *dalc.SubmitBlockRequest) (*dalc.SubmitBlockResponse, error) {
+	height, err := d.submitBlock(ctx, blockReq.Block)
+	if err != nil {
+		return &dalc.SubmitBlockResponse{
+			Result: &dalc.DAResponse{Code: dalc.StatusCode_STATUS_CODE_ERROR, Message: err.Error()},
+		}, err
+	}
+
+	return &dalc.SubmitBlockResponse{
+		Result: &dalc.DAResponse{
+			Code:            dalc.StatusCode_STATUS_CODE_SUCCESS,
+			DataLayerHeight: height,
+		},
+	}, nil
+}
+
+// GetInfo describes the mock, which has no signer
+func (d *DataAvailabilityLightClient) GetInfo(ctx context.Context, req *dalc.GetInfoRequest) (*dalc.GetInfoResponse, error) {
+	return &dalc.GetInfoResponse{
+		Result:          &dalc.DAResponse{Code: dalc.StatusCode_STATUS_CODE_SUCCESS},
+		Version:         "mock",
+		Namespace:       d.namespace,
+		DataLayerHeight: d.Height(),
+	}, nil
+}
+
+func (d *DataAvailabilityLightClient) submitBlock(ctx context.Context, block *optimint.Block) (uint64, error) {
+	err := d.wait(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if block == nil || block.Header == nil {
+		return 0, errors.New("block and block header must not be empty")
+	}
+
+	rawBlock, err := proto.Marshal(block)
+	if err != nil {
+		return 0, err
+	}
+
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	height := d.height
+	if d.blockTime == 0 {
+		height++
+		err = d.store.SetHeight(height)
+		if err != nil {
+			return 0, err
+		}
+		d.height = height
+	}
+
+	err = d.store.Put(height, block.Header.NamespaceId, rawBlock)
+	if err != nil {
+		return 0, err
+	}
+	return height, nil
+}
+
+// CheckBlockAvailability reports the data posted at the requested height as
+// available, whether or not it holds blocks of the served namespace, unless
+// the height is configured to be unavailable
+func (d *DataAvailabilityLightClient) CheckBlockAvailability(ctx context.Context, req *dalc.CheckBlockAvailabilityRequest) (*dalc.CheckBlockAvailabilityResponse, error) {
+	_, err := d.namespacedBlocks(ctx, req.DataLayerHeight)
+	switch {
+	case errors.Is(err, ErrUnavailable):
+		return &dalc.CheckBlockAvailabilityResponse{
+			Result: &dalc.DAResponse{
+				Code:            dalc.StatusCode_STATUS_CODE_SUCCESS,
+				Message:         err.Error(),
+				DataLayerHeight: req.DataLayerHeight,
+			},
+			DataAvailable: false,
+		}, nil
+	case err != nil:
+		return &dalc.CheckBlockAvailabilityResponse{
+			Result: &dalc.DAResponse{
+				Code:    dalc.StatusCode_STATUS_CODE_UNSPECIFIED,
+				Message: err.Error(),
+			},
+			DataAvailable: false,
+		}, err
+	}
+
+	return &dalc.CheckBlockAvailabilityResponse{
+		Result: &dalc.DAResponse{
+			Code:            dalc.StatusCode_STATUS_CODE_SUCCESS,
+			DataLayerHeight: req.DataLayerHeight,
+		},
+		DataAvailable: true,
+	}, nil
+}
+
+// RetrieveBlocks returns all blocks of the served namespace that were posted
+// at the requested height
+func (d *DataAvailabilityLightClient) RetrieveBlocks(ctx context.Context, req *dalc.RetrieveBlocksRequest) (*dalc.RetrieveBlocksResponse, error) {
+	rawBlocks, err := d.namespacedBlocks(ctx, req.DataLayerHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	var blocks []*optimint.Block
+	for _, rawBlock := range rawBlocks {
+		var block optimint.Block
+		err = proto.Unmarshal(rawBlock, &block)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, &block)
+	}
+
+	return &dalc.RetrieveBlocksResponse{
+		Result: &dalc.DAResponse{
+			Code:            dalc.StatusCode_STATUS_CODE_SUCCESS,
+			DataLayerHeight: req.DataLayerHeight,
+		},
+		Blocks: blocks,
+	}, nil
+}
+
+// namespacedBlocks returns the raw blocks of the served namespace posted at
+// the provided height
+func (d *DataAvailabilityLightClient) namespacedBlocks(ctx context.Context, height uint64) ([][]byte, error) {
+	err := d.wait(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	d.mtx.RLock()
+	defer d.mtx.RUnlock()
+
+	if height > d.height {
+		return nil, fmt.Errorf("height %d is in the future: latest height %d", height, d.height)
+	}
+	if _, unavailable := d.unavailableHeights[height]; unavailable {
+		return nil, fmt.Errorf("height %d: %w", height, ErrUnavailable)
+	}
+
+	return d.store.Get(height, d.namespace)
+}
+
+// wait blocks for the configured delay or until the context is done
+func (d *DataAvailabilityLightClient) wait(ctx context.Context) error {
+	if d.delay == 0 {
+		return nil
+	}
+	timer := time.NewTimer(d.delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
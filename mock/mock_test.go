@@ -0,0 +1,121 @@
+package mock
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/dalc/proto/dalc"
+	"github.com/celestiaorg/dalc/proto/optimint"
+)
+
+func TestSubmitRetrieve(t *testing.T) {
+	ctx := context.Background()
+	namespace := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	lc := New(namespace, 0)
+
+	block := testBlock(1, namespace)
+	submitResp, err := lc.SubmitBlock(ctx, &dalc.SubmitBlockRequest{Block: block})
+	require.NoError(t, err)
+	assert.Equal(t, dalc.StatusCode_STATUS_CODE_SUCCESS, submitResp.Result.Code)
+	assert.Equal(t, uint64(1), submitResp.Result.DataLayerHeight)
+
+	// blocks of other namespaces are not served
+	otherResp, err := lc.SubmitBlock(ctx, &dalc.SubmitBlockRequest{Block: testBlock(2, []byte{8, 7, 6, 5, 4, 3, 2, 1})})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), otherResp.Result.DataLayerHeight)
+
+	retrieveResp, err := lc.RetrieveBlocks(ctx, &dalc.RetrieveBlocksRequest{DataLayerHeight: 1})
+	require.NoError(t, err)
+	require.Len(t, retrieveResp.Blocks, 1)
+	assert.Equal(t, *block, *retrieveResp.Blocks[0])
+
+	retrieveResp, err = lc.RetrieveBlocks(ctx, &dalc.RetrieveBlocksRequest{DataLayerHeight: 2})
+	require.NoError(t, err)
+	assert.Empty(t, retrieveResp.Blocks)
+
+	availResp, err := lc.CheckBlockAvailability(ctx, &dalc.CheckBlockAvailabilityRequest{DataLayerHeight: 1})
+	require.NoError(t, err)
+	assert.True(t, availResp.DataAvailable)
+
+	// heights without blocks of the namespace are available all the same
+	availResp, err = lc.CheckBlockAvailability(ctx, &dalc.CheckBlockAvailabilityRequest{DataLayerHeight: 2})
+	require.NoError(t, err)
+	assert.True(t, availResp.DataAvailable)
+
+	_, err = lc.CheckBlockAvailability(ctx, &dalc.CheckBlockAvailabilityRequest{DataLayerHeight: 3})
+	assert.Error(t, err)
+
+	_, err = lc.RetrieveBlocks(ctx, &dalc.RetrieveBlocksRequest{DataLayerHeight: 3})
+	assert.Error(t, err)
+
+	info, err := lc.GetInfo(ctx, &dalc.GetInfoRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, namespace, info.Namespace)
+	assert.Equal(t, uint64(2), info.DataLayerHeight)
+}
+
+func TestBlockTime(t *testing.T) {
+	ctx := context.Background()
+	namespace := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	lc := New(namespace, time.Millisecond*10)
+	require.NoError(t, lc.Start(ctx))
+	t.Cleanup(func() {
+		require.NoError(t, lc.Stop(ctx))
+	})
+
+	first, err := lc.SubmitBlock(ctx, &dalc.SubmitBlockRequest{Block: testBlock(1, namespace)})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return lc.Height() > first.Result.DataLayerHeight
+	}, time.Second, time.Millisecond*10)
+
+	second, err := lc.SubmitBlock(ctx, &dalc.SubmitBlockRequest{Block: testBlock(2, namespace)})
+	require.NoError(t, err)
+	assert.Greater(t, second.Result.DataLayerHeight, first.Result.DataLayerHeight)
+}
+
+func testBlock(height uint64, namespace []byte) *optimint.Block {
+	return &optimint.Block{
+		Header: &optimint.Header{
+			Height:      height,
+			NamespaceId: namespace,
+		},
+		Data: &optimint.Data{
+			Txs: [][]byte{{1}, {2}, {3, 4}},
+		},
+		LastCommit: &optimint.Commit{
+			Height: height,
+		},
+	}
+}
+
+func TestUnavailableHeights(t *testing.T) {
+	ctx := context.Background()
+	namespace := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	lc := New(namespace, 0, WithUnavailableHeights(1))
+
+	_, err := lc.SubmitBlock(ctx, &dalc.SubmitBlockRequest{Block: testBlock(1, namespace)})
+	require.NoError(t, err)
+
+	availResp, err := lc.CheckBlockAvailability(ctx, &dalc.CheckBlockAvailabilityRequest{DataLayerHeight: 1})
+	require.NoError(t, err)
+	assert.False(t, availResp.DataAvailable)
+
+	_, err = lc.RetrieveBlocks(ctx, &dalc.RetrieveBlocksRequest{DataLayerHeight: 1})
+	assert.ErrorIs(t, err, ErrUnavailable)
+}
+
+func TestDelay(t *testing.T) {
+	namespace := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	lc := New(namespace, 0, WithDelay(time.Second))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+	defer cancel()
+	_, err := lc.SubmitBlock(ctx, &dalc.SubmitBlockRequest{Block: testBlock(1, namespace)})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,65 @@
+package mock
+
+import (
+	"encoding/hex"
+	"sync"
+)
+
+// Store persists the blocks posted to the mock data availability layer
+type Store interface {
+	// Put appends a serialized block to the blocks posted at the provided
+	// height and namespace
+	Put(height uint64, namespace, block []byte) error
+	// Get returns the serialized blocks posted at the provided height and
+	// namespace in the order they were submitted
+	Get(height uint64, namespace []byte) ([][]byte, error)
+	// Height returns the latest stored DA height
+	Height() uint64
+	// SetHeight stores the latest DA height
+	SetHeight(height uint64) error
+}
+
+// NewMemStore creates a Store that only keeps blocks in memory
+func NewMemStore() Store {
+	return &memStore{
+		blocks: make(map[uint64]map[string][][]byte),
+	}
+}
+
+type memStore struct {
+	mtx    sync.RWMutex
+	height uint64
+	blocks map[uint64]map[string][][]byte
+}
+
+func (ms *memStore) Put(height uint64, namespace, block []byte) error {
+	ms.mtx.Lock()
+	defer ms.mtx.Unlock()
+	nsBlocks, has := ms.blocks[height]
+	if !has {
+		nsBlocks = make(map[string][][]byte)
+		ms.blocks[height] = nsBlocks
+	}
+	key := hex.EncodeToString(namespace)
+	nsBlocks[key] = append(nsBlocks[key], block)
+	return nil
+}
+
+func (ms *memStore) Get(height uint64, namespace []byte) ([][]byte, error) {
+	ms.mtx.RLock()
+	defer ms.mtx.RUnlock()
+	return ms.blocks[height][hex.EncodeToString(namespace)], nil
+}
+
+func (ms *memStore) Height() uint64 {
+	ms.mtx.RLock()
+	defer ms.mtx.RUnlock()
+	return ms.height
+}
+
+func (ms *memStore) SetHeight(height uint64) error {
+	ms.mtx.Lock()
+	defer ms.mtx.Unlock()
+	ms.height = height
+	return nil
+}
@@ -0,0 +1,1268 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dalc/chaos.proto
+
+package dalc
+
+import (
+	context "context"
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Faults describes the misbehavior injected into the DALCService. Rates are
+// probabilities between 0 and 1.
+type Faults struct {
+	// delay_ms is added to every response
+	DelayMs uint64 `protobuf:"varint,1,opt,name=delay_ms,json=delayMs,proto3" json:"delay_ms,omitempty"`
+	// error_rate is the rate of requests failing with STATUS_CODE_ERROR
+	ErrorRate float64 `protobuf:"fixed64,2,opt,name=error_rate,json=errorRate,proto3" json:"error_rate,omitempty"`
+	// timeout_rate is the rate of requests failing with STATUS_CODE_TIMEOUT
+	TimeoutRate float64 `protobuf:"fixed64,3,opt,name=timeout_rate,json=timeoutRate,proto3" json:"timeout_rate,omitempty"`
+	// drop_rate is the rate of submitted blocks silently not being posted and
+	// of retrieved blocks being left out of the response
+	DropRate float64 `protobuf:"fixed64,4,opt,name=drop_rate,json=dropRate,proto3" json:"drop_rate,omitempty"`
+	// reorder_rate is the rate of retrieved blocks being returned shuffled
+	ReorderRate float64 `protobuf:"fixed64,5,opt,name=reorder_rate,json=reorderRate,proto3" json:"reorder_rate,omitempty"`
+	// duplicate_rate is the rate of each retrieved block being returned twice
+	DuplicateRate float64 `protobuf:"fixed64,6,opt,name=duplicate_rate,json=duplicateRate,proto3" json:"duplicate_rate,omitempty"`
+	// false_availability_rate is the rate of availability checks reporting the
+	// opposite result
+	FalseAvailabilityRate float64 `protobuf:"fixed64,7,opt,name=false_availability_rate,json=falseAvailabilityRate,proto3" json:"false_availability_rate,omitempty"`
+}
+
+func (m *Faults) Reset()         { *m = Faults{} }
+func (m *Faults) String() string { return proto.CompactTextString(m) }
+func (*Faults) ProtoMessage()    {}
+func (*Faults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4cb95f51fdebb65, []int{0}
+}
+func (m *Faults) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Faults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Faults.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Faults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Faults.Merge(m, src)
+}
+func (m *Faults) XXX_Size() int {
+	return m.Size()
+}
+func (m *Faults) XXX_DiscardUnknown() {
+	xxx_messageInfo_Faults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Faults proto.InternalMessageInfo
+
+func (m *Faults) GetDelayMs() uint64 {
+	if m != nil {
+		return m.DelayMs
+	}
+	return 0
+}
+
+func (m *Faults) GetErrorRate() float64 {
+	if m != nil {
+		return m.ErrorRate
+	}
+	return 0
+}
+
+func (m *Faults) GetTimeoutRate() float64 {
+	if m != nil {
+		return m.TimeoutRate
+	}
+	return 0
+}
+
+func (m *Faults) GetDropRate() float64 {
+	if m != nil {
+		return m.DropRate
+	}
+	return 0
+}
+
+func (m *Faults) GetReorderRate() float64 {
+	if m != nil {
+		return m.ReorderRate
+	}
+	return 0
+}
+
+func (m *Faults) GetDuplicateRate() float64 {
+	if m != nil {
+		return m.DuplicateRate
+	}
+	return 0
+}
+
+func (m *Faults) GetFalseAvailabilityRate() float64 {
+	if m != nil {
+		return m.FalseAvailabilityRate
+	}
+	return 0
+}
+
+type GetFaultsRequest struct {
+}
+
+func (m *GetFaultsRequest) Reset()         { *m = GetFaultsRequest{} }
+func (m *GetFaultsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFaultsRequest) ProtoMessage()    {}
+func (*GetFaultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4cb95f51fdebb65, []int{1}
+}
+func (m *GetFaultsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetFaultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetFaultsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetFaultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFaultsRequest.Merge(m, src)
+}
+func (m *GetFaultsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetFaultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFaultsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFaultsRequest proto.InternalMessageInfo
+
+type GetFaultsResponse struct {
+	Faults *Faults `protobuf:"bytes,1,opt,name=faults,proto3" json:"faults,omitempty"`
+}
+
+func (m *GetFaultsResponse) Reset()         { *m = GetFaultsResponse{} }
+func (m *GetFaultsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFaultsResponse) ProtoMessage()    {}
+func (*GetFaultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4cb95f51fdebb65, []int{2}
+}
+func (m *GetFaultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetFaultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetFaultsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetFaultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFaultsResponse.Merge(m, src)
+}
+func (m *GetFaultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetFaultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFaultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFaultsResponse proto.InternalMessageInfo
+
+func (m *GetFaultsResponse) GetFaults() *Faults {
+	if m != nil {
+		return m.Faults
+	}
+	return nil
+}
+
+type SetFaultsRequest struct {
+	Faults *Faults `protobuf:"bytes,1,opt,name=faults,proto3" json:"faults,omitempty"`
+}
+
+func (m *SetFaultsRequest) Reset()         { *m = SetFaultsRequest{} }
+func (m *SetFaultsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetFaultsRequest) ProtoMessage()    {}
+func (*SetFaultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4cb95f51fdebb65, []int{3}
+}
+func (m *SetFaultsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetFaultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetFaultsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetFaultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetFaultsRequest.Merge(m, src)
+}
+func (m *SetFaultsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetFaultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetFaultsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetFaultsRequest proto.InternalMessageInfo
+
+func (m *SetFaultsRequest) GetFaults() *Faults {
+	if m != nil {
+		return m.Faults
+	}
+	return nil
+}
+
+type SetFaultsResponse struct {
+	Faults *Faults `protobuf:"bytes,1,opt,name=faults,proto3" json:"faults,omitempty"`
+}
+
+func (m *SetFaultsResponse) Reset()         { *m = SetFaultsResponse{} }
+func (m *SetFaultsResponse) String() string { return proto.CompactTextString(m) }
+func (*SetFaultsResponse) ProtoMessage()    {}
+func (*SetFaultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4cb95f51fdebb65, []int{4}
+}
+func (m *SetFaultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetFaultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetFaultsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetFaultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetFaultsResponse.Merge(m, src)
+}
+func (m *SetFaultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetFaultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetFaultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetFaultsResponse proto.InternalMessageInfo
+
+func (m *SetFaultsResponse) GetFaults() *Faults {
+	if m != nil {
+		return m.Faults
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Faults)(nil), "dalc.Faults")
+	proto.RegisterType((*GetFaultsRequest)(nil), "dalc.GetFaultsRequest")
+	proto.RegisterType((*GetFaultsResponse)(nil), "dalc.GetFaultsResponse")
+	proto.RegisterType((*SetFaultsRequest)(nil), "dalc.SetFaultsRequest")
+	proto.RegisterType((*SetFaultsResponse)(nil), "dalc.SetFaultsResponse")
+}
+
+func init() { proto.RegisterFile("dalc/chaos.proto", fileDescriptor_c4cb95f51fdebb65) }
+
+var fileDescriptor_c4cb95f51fdebb65 = []byte{
+	// 377 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcf, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x33, 0x6b, 0xcd, 0x6e, 0xdf, 0x56, 0xe9, 0x0e, 0xe8, 0x56, 0xc5, 0x50, 0x83, 0x4a,
+	0x4f, 0x29, 0x54, 0x10, 0x7b, 0x11, 0x7f, 0x80, 0x9e, 0xbc, 0x24, 0x37, 0x2f, 0x65, 0x9a, 0xbc,
+	0xb6, 0x03, 0x53, 0x27, 0xce, 0x4c, 0x0a, 0xbd, 0x7b, 0x16, 0xff, 0x2c, 0x8f, 0x3d, 0x7a, 0x94,
+	0xf6, 0x1f, 0x91, 0xbc, 0x89, 0xa5, 0xb4, 0x08, 0xb2, 0xb7, 0xe9, 0xf7, 0xfb, 0xf9, 0xf4, 0x31,
+	0x2f, 0x03, 0xdd, 0x42, 0xa8, 0x7c, 0x98, 0x2f, 0x84, 0xb6, 0x49, 0x69, 0xb4, 0xd3, 0xbc, 0x55,
+	0x27, 0xf1, 0xb7, 0x33, 0x08, 0x3f, 0x88, 0x4a, 0x39, 0xcb, 0x1f, 0xc0, 0x45, 0x81, 0x4a, 0xac,
+	0x27, 0x4b, 0xdb, 0x63, 0x7d, 0x36, 0x68, 0xa5, 0xe7, 0xf4, 0xfb, 0x93, 0xe5, 0x8f, 0x01, 0xd0,
+	0x18, 0x6d, 0x26, 0x46, 0x38, 0xec, 0x9d, 0xf5, 0xd9, 0x80, 0xa5, 0x6d, 0x4a, 0x52, 0xe1, 0x90,
+	0x3f, 0x81, 0x8e, 0x93, 0x4b, 0xd4, 0x95, 0xf3, 0xc0, 0x2d, 0x02, 0x2e, 0x9b, 0x8c, 0x90, 0x47,
+	0xd0, 0x2e, 0x8c, 0x2e, 0x7d, 0xdf, 0xa2, 0xfe, 0xa2, 0x0e, 0xfe, 0xfa, 0x06, 0xb5, 0x29, 0xb0,
+	0x19, 0x70, 0xdb, 0xfb, 0x4d, 0x46, 0xc8, 0x33, 0xb8, 0x5b, 0x54, 0xa5, 0x92, 0xb9, 0x70, 0xe8,
+	0xa1, 0x90, 0xa0, 0x3b, 0xfb, 0x94, 0xb0, 0x97, 0x70, 0x3d, 0x13, 0xca, 0xe2, 0x44, 0xac, 0x84,
+	0x54, 0x62, 0x2a, 0x95, 0x74, 0x6b, 0xcf, 0x9f, 0x13, 0x7f, 0x8f, 0xea, 0xb7, 0x07, 0x6d, 0xed,
+	0xc5, 0x1c, 0xba, 0x1f, 0xd1, 0xf9, 0x45, 0xa4, 0xf8, 0xb5, 0x42, 0xeb, 0xe2, 0x31, 0x5c, 0x1d,
+	0x64, 0xb6, 0xd4, 0x5f, 0x2c, 0xf2, 0xa7, 0x10, 0xce, 0x28, 0xa1, 0x15, 0x5d, 0x8e, 0x3a, 0x49,
+	0xbd, 0xc6, 0xa4, 0xa1, 0x9a, 0x2e, 0x7e, 0x05, 0xdd, 0xec, 0xe8, 0xef, 0xfe, 0xd3, 0x1c, 0xc3,
+	0x55, 0x76, 0xb3, 0xa1, 0xa3, 0xef, 0x0c, 0x3a, 0xef, 0xeb, 0x0f, 0x9c, 0xa1, 0x59, 0xc9, 0x1c,
+	0xf9, 0x6b, 0x68, 0xef, 0x2f, 0xc0, 0xef, 0x7b, 0xe7, 0xf8, 0x96, 0x0f, 0xaf, 0x4f, 0x72, 0x3f,
+	0x34, 0x0e, 0x6a, 0x3f, 0x3b, 0xf6, 0xb3, 0x7f, 0xf8, 0xd9, 0xa9, 0xff, 0xee, 0xcd, 0xcf, 0x6d,
+	0xc4, 0x36, 0xdb, 0x88, 0xfd, 0xde, 0x46, 0xec, 0xc7, 0x2e, 0x0a, 0x36, 0xbb, 0x28, 0xf8, 0xb5,
+	0x8b, 0x82, 0xcf, 0xcf, 0xe7, 0xd2, 0x2d, 0xaa, 0x69, 0x92, 0xeb, 0xe5, 0x30, 0x47, 0x85, 0xd6,
+	0x49, 0xa1, 0xcd, 0x7c, 0x48, 0x8f, 0x94, 0x9e, 0x27, 0x1d, 0xa7, 0x21, 0x9d, 0x5f, 0xfc, 0x19,
+	0x00, 0x2d, 0x7f, 0x94, 0x36, 0xbe, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ChaosServiceClient is the client API for ChaosService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ChaosServiceClient interface {
+	GetFaults(ctx context.Context, in *GetFaultsRequest, opts ...grpc.CallOption) (*GetFaultsResponse, error)
+	SetFaults(ctx context.Context, in *SetFaultsRequest, opts ...grpc.CallOption) (*SetFaultsResponse, error)
+}
+
+type chaosServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewChaosServiceClient(cc *grpc.ClientConn) ChaosServiceClient {
+	return &chaosServiceClient{cc}
+}
+
+func (c *chaosServiceClient) GetFaults(ctx context.Context, in *GetFaultsRequest, opts ...grpc.CallOption) (*GetFaultsResponse, error) {
+	out := new(GetFaultsResponse)
+	err := c.cc.Invoke(ctx, "/dalc.ChaosService/GetFaults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosServiceClient) SetFaults(ctx context.Context, in *SetFaultsRequest, opts ...grpc.CallOption) (*SetFaultsResponse, error) {
+	out := new(SetFaultsResponse)
+	err := c.cc.Invoke(ctx, "/dalc.ChaosService/SetFaults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ChaosServiceServer is the server API for ChaosService service.
+type ChaosServiceServer interface {
+	GetFaults(context.Context, *GetFaultsRequest) (*GetFaultsResponse, error)
+	SetFaults(context.Context, *SetFaultsRequest) (*SetFaultsResponse, error)
+}
+
+// UnimplementedChaosServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedChaosServiceServer struct {
+}
+
+func (*UnimplementedChaosServiceServer) GetFaults(ctx context.Context, req *GetFaultsRequest) (*GetFaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFaults not implemented")
+}
+func (*UnimplementedChaosServiceServer) SetFaults(ctx context.Context, req *SetFaultsRequest) (*SetFaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFaults not implemented")
+}
+
+func RegisterChaosServiceServer(s *grpc.Server, srv ChaosServiceServer) {
+	s.RegisterService(&_ChaosService_serviceDesc, srv)
+}
+
+func _ChaosService_GetFaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosServiceServer).GetFaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dalc.ChaosService/GetFaults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosServiceServer).GetFaults(ctx, req.(*GetFaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosService_SetFaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosServiceServer).SetFaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dalc.ChaosService/SetFaults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosServiceServer).SetFaults(ctx, req.(*SetFaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ChaosService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dalc.ChaosService",
+	HandlerType: (*ChaosServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetFaults",
+			Handler:    _ChaosService_GetFaults_Handler,
+		},
+		{
+			MethodName: "SetFaults",
+			Handler:    _ChaosService_SetFaults_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dalc/chaos.proto",
+}
+
+func (m *Faults) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Faults) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Faults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FalseAvailabilityRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FalseAvailabilityRate))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.DuplicateRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.DuplicateRate))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.ReorderRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ReorderRate))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.DropRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.DropRate))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.TimeoutRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.TimeoutRate))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.ErrorRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ErrorRate))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.DelayMs != 0 {
+		i = encodeVarintChaos(dAtA, i, uint64(m.DelayMs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetFaultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFaultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFaultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetFaultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFaultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFaultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Faults != nil {
+		{
+			size, err := m.Faults.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChaos(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetFaultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetFaultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetFaultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Faults != nil {
+		{
+			size, err := m.Faults.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChaos(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetFaultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetFaultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetFaultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Faults != nil {
+		{
+			size, err := m.Faults.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChaos(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintChaos(dAtA []byte, offset int, v uint64) int {
+	offset -= sovChaos(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Faults) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DelayMs != 0 {
+		n += 1 + sovChaos(uint64(m.DelayMs))
+	}
+	if m.ErrorRate != 0 {
+		n += 9
+	}
+	if m.TimeoutRate != 0 {
+		n += 9
+	}
+	if m.DropRate != 0 {
+		n += 9
+	}
+	if m.ReorderRate != 0 {
+		n += 9
+	}
+	if m.DuplicateRate != 0 {
+		n += 9
+	}
+	if m.FalseAvailabilityRate != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *GetFaultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetFaultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Faults != nil {
+		l = m.Faults.Size()
+		n += 1 + l + sovChaos(uint64(l))
+	}
+	return n
+}
+
+func (m *SetFaultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Faults != nil {
+		l = m.Faults.Size()
+		n += 1 + l + sovChaos(uint64(l))
+	}
+	return n
+}
+
+func (m *SetFaultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Faults != nil {
+		l = m.Faults.Size()
+		n += 1 + l + sovChaos(uint64(l))
+	}
+	return n
+}
+
+func sovChaos(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozChaos(x uint64) (n int) {
+	return sovChaos(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Faults) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Faults: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Faults: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayMs", wireType)
+			}
+			m.DelayMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayMs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ErrorRate = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.TimeoutRate = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DropRate = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReorderRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ReorderRate = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DuplicateRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DuplicateRate = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FalseAvailabilityRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FalseAvailabilityRate = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetFaultsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetFaultsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetFaultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetFaultsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetFaultsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetFaultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Faults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Faults == nil {
+				m.Faults = &Faults{}
+			}
+			if err := m.Faults.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetFaultsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetFaultsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetFaultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Faults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Faults == nil {
+				m.Faults = &Faults{}
+			}
+			if err := m.Faults.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetFaultsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetFaultsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetFaultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Faults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Faults == nil {
+				m.Faults = &Faults{}
+			}
+			if err := m.Faults.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipChaos(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowChaos
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChaos
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthChaos
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupChaos
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthChaos
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthChaos        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowChaos          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupChaos = fmt.Errorf("proto: unexpected end of group")
+)
@@ -164,6 +164,9 @@ func (m *SubmitBlockRequest) GetBlock() *optimint.Block {
 
 type SubmitBlockResponse struct {
 	Result *DAResponse `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// signer_address is the address of the account that signed the
+	// transaction, if it was signed
+	SignerAddress string `protobuf:"bytes,2,opt,name=signer_address,json=signerAddress,proto3" json:"signer_address,omitempty"`
 }
 
 func (m *SubmitBlockResponse) Reset()         { *m = SubmitBlockResponse{} }
@@ -206,6 +209,13 @@ func (m *SubmitBlockResponse) GetResult() *DAResponse {
 	return nil
 }
 
+func (m *SubmitBlockResponse) GetSignerAddress() string {
+	if m != nil {
+		return m.SignerAddress
+	}
+	return ""
+}
+
 type CheckBlockAvailabilityRequest struct {
 	DataLayerHeight uint64 `protobuf:"varint,1,opt,name=data_layer_height,json=dataLayerHeight,proto3" json:"data_layer_height,omitempty"`
 }
@@ -398,6 +408,178 @@ func (m *RetrieveBlocksResponse) GetBlocks() []*optimint.Block {
 	return nil
 }
 
+type GetInfoRequest struct {
+}
+
+func (m *GetInfoRequest) Reset()         { *m = GetInfoRequest{} }
+func (m *GetInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetInfoRequest) ProtoMessage()    {}
+func (*GetInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45d7d8eda2693dc1, []int{7}
+}
+func (m *GetInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetInfoRequest.Merge(m, src)
+}
+func (m *GetInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetInfoRequest proto.InternalMessageInfo
+
+type GetInfoResponse struct {
+	Result *DAResponse `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// version of the dalc
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// namespace the blocks are submitted to and retrieved from
+	Namespace []byte `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// chain_id of the celestia chain transactions are signed for
+	ChainId string `protobuf:"bytes,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// signer_address is the address of the first account paying for
+	// submissions
+	SignerAddress string `protobuf:"bytes,5,opt,name=signer_address,json=signerAddress,proto3" json:"signer_address,omitempty"`
+	// balance of the signer account with the lowest balance in denom
+	Balance string `protobuf:"bytes,6,opt,name=balance,proto3" json:"balance,omitempty"`
+	Denom   string `protobuf:"bytes,7,opt,name=denom,proto3" json:"denom,omitempty"`
+	// data_layer_height is the latest celestia height known to the node
+	DataLayerHeight uint64 `protobuf:"varint,8,opt,name=data_layer_height,json=dataLayerHeight,proto3" json:"data_layer_height,omitempty"`
+	// pending_submissions is the number of blocks currently being submitted
+	PendingSubmissions uint64 `protobuf:"varint,9,opt,name=pending_submissions,json=pendingSubmissions,proto3" json:"pending_submissions,omitempty"`
+	// low_balance is true when the balance of the signer account is below the
+	// configured threshold or can not cover the fee of a submission
+	LowBalance bool `protobuf:"varint,10,opt,name=low_balance,json=lowBalance,proto3" json:"low_balance,omitempty"`
+	// signer_addresses are the addresses of all the accounts paying for
+	// submissions, which sign them in turn
+	SignerAddresses []string `protobuf:"bytes,11,rep,name=signer_addresses,json=signerAddresses,proto3" json:"signer_addresses,omitempty"`
+}
+
+func (m *GetInfoResponse) Reset()         { *m = GetInfoResponse{} }
+func (m *GetInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetInfoResponse) ProtoMessage()    {}
+func (*GetInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45d7d8eda2693dc1, []int{8}
+}
+func (m *GetInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetInfoResponse.Merge(m, src)
+}
+func (m *GetInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetInfoResponse proto.InternalMessageInfo
+
+func (m *GetInfoResponse) GetResult() *DAResponse {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *GetInfoResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *GetInfoResponse) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *GetInfoResponse) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *GetInfoResponse) GetSignerAddress() string {
+	if m != nil {
+		return m.SignerAddress
+	}
+	return ""
+}
+
+func (m *GetInfoResponse) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *GetInfoResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *GetInfoResponse) GetDataLayerHeight() uint64 {
+	if m != nil {
+		return m.DataLayerHeight
+	}
+	return 0
+}
+
+func (m *GetInfoResponse) GetPendingSubmissions() uint64 {
+	if m != nil {
+		return m.PendingSubmissions
+	}
+	return 0
+}
+
+func (m *GetInfoResponse) GetLowBalance() bool {
+	if m != nil {
+		return m.LowBalance
+	}
+	return false
+}
+
+func (m *GetInfoResponse) GetSignerAddresses() []string {
+	if m != nil {
+		return m.SignerAddresses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("dalc.StatusCode", StatusCode_name, StatusCode_value)
 	proto.RegisterType((*DAResponse)(nil), "dalc.DAResponse")
@@ -407,45 +589,59 @@ func init() {
 	proto.RegisterType((*CheckBlockAvailabilityResponse)(nil), "dalc.CheckBlockAvailabilityResponse")
 	proto.RegisterType((*RetrieveBlocksRequest)(nil), "dalc.RetrieveBlocksRequest")
 	proto.RegisterType((*RetrieveBlocksResponse)(nil), "dalc.RetrieveBlocksResponse")
+	proto.RegisterType((*GetInfoRequest)(nil), "dalc.GetInfoRequest")
+	proto.RegisterType((*GetInfoResponse)(nil), "dalc.GetInfoResponse")
 }
 
 func init() { proto.RegisterFile("dalc/dalc.proto", fileDescriptor_45d7d8eda2693dc1) }
 
 var fileDescriptor_45d7d8eda2693dc1 = []byte{
-	// 521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6e, 0xda, 0x4c,
-	0x10, 0xc7, 0x31, 0xf0, 0xf1, 0xb5, 0x83, 0x0a, 0x64, 0xa3, 0x04, 0x97, 0xb4, 0x16, 0x72, 0x93,
-	0x16, 0xe5, 0x00, 0x12, 0x3d, 0xf6, 0xd0, 0x12, 0xe3, 0xaa, 0xa8, 0x49, 0xa9, 0xd6, 0x70, 0xe9,
-	0x05, 0x2d, 0x66, 0x04, 0x2b, 0x4c, 0x4c, 0xbc, 0x0b, 0x6a, 0x1e, 0xa1, 0xb7, 0x3e, 0x56, 0x8f,
-	0x39, 0xf6, 0x58, 0xc1, 0x8b, 0x54, 0x5e, 0x9b, 0x84, 0xa4, 0x6e, 0xa4, 0xf4, 0x62, 0xcd, 0xce,
-	0x6f, 0x67, 0xe6, 0x3f, 0x9e, 0xd1, 0x42, 0x71, 0xc4, 0x3c, 0xb7, 0x11, 0x7e, 0xea, 0xf3, 0xc0,
-	0x97, 0x3e, 0xc9, 0x86, 0x76, 0xa5, 0xec, 0xcf, 0x25, 0x9f, 0xf1, 0x73, 0xd9, 0xd8, 0x18, 0x11,
-	0x36, 0xbf, 0x02, 0xb4, 0x5b, 0x14, 0xc5, 0xdc, 0x3f, 0x17, 0x48, 0x0e, 0x21, 0xeb, 0xfa, 0x23,
-	0xd4, 0xb5, 0xaa, 0x56, 0x2b, 0x34, 0x4b, 0x75, 0x95, 0xc7, 0x91, 0x4c, 0x2e, 0x84, 0xe5, 0x8f,
-	0x90, 0x2a, 0x4a, 0x74, 0xf8, 0x7f, 0x86, 0x42, 0xb0, 0x31, 0xea, 0xe9, 0xaa, 0x56, 0x7b, 0x4c,
-	0x37, 0x47, 0x72, 0x0c, 0x3b, 0x23, 0x26, 0xd9, 0xc0, 0x63, 0x97, 0x18, 0x0c, 0x26, 0xc8, 0xc7,
-	0x13, 0xa9, 0x67, 0xaa, 0x5a, 0x2d, 0x4b, 0x8b, 0x21, 0x38, 0x0d, 0xfd, 0x1f, 0x94, 0xdb, 0x7c,
-	0x03, 0xc4, 0x59, 0x0c, 0x67, 0x5c, 0x9e, 0x78, 0xbe, 0x3b, 0xa5, 0x78, 0xb1, 0x40, 0x21, 0xc9,
-	0x11, 0xfc, 0x37, 0x0c, 0xcf, 0x4a, 0x42, 0xbe, 0x59, 0xac, 0x5f, 0xeb, 0x8d, 0xae, 0x45, 0xd4,
-	0x7c, 0x0b, 0xbb, 0xb7, 0x82, 0x63, 0xfd, 0x35, 0xc8, 0x05, 0x28, 0x16, 0x9e, 0x8c, 0xc3, 0xe3,
-	0x0e, 0x6e, 0x3a, 0xa4, 0x31, 0x37, 0x3f, 0xc2, 0x73, 0x6b, 0x82, 0xee, 0x54, 0xc5, 0xb7, 0x96,
-	0x8c, 0x7b, 0x6c, 0xc8, 0x3d, 0x2e, 0x2f, 0x37, 0x42, 0x12, 0x5b, 0xd1, 0x92, 0x5b, 0xb9, 0x00,
-	0xe3, 0x6f, 0xc9, 0x1e, 0x2a, 0x8c, 0x1c, 0x41, 0x41, 0xd5, 0x65, 0x51, 0x1a, 0x2f, 0xfa, 0xc7,
-	0x8f, 0xe8, 0x93, 0xd0, 0xdb, 0xda, 0x38, 0x4d, 0x0b, 0xf6, 0x28, 0xca, 0x80, 0xe3, 0x12, 0x55,
-	0x55, 0xf1, 0x2f, 0xba, 0xa7, 0xb0, 0x7f, 0x37, 0xc9, 0x83, 0xf5, 0xbe, 0x82, 0x9c, 0x1a, 0x89,
-	0xd0, 0xd3, 0xd5, 0x4c, 0xd2, 0xc4, 0x62, 0x7c, 0x1c, 0x00, 0xdc, 0x6c, 0x12, 0x39, 0x80, 0xb2,
-	0xd3, 0x6b, 0xf5, 0xfa, 0xce, 0xc0, 0xea, 0xb6, 0xed, 0x41, 0xff, 0x93, 0xf3, 0xd9, 0xb6, 0x3a,
-	0xef, 0x3b, 0x76, 0xbb, 0x94, 0x22, 0x65, 0xd8, 0xdd, 0x86, 0x4e, 0xdf, 0xb2, 0x6c, 0xc7, 0x29,
-	0x69, 0x77, 0x41, 0xaf, 0x73, 0x66, 0x77, 0xfb, 0xbd, 0x52, 0x9a, 0xec, 0xc1, 0xce, 0x36, 0xb0,
-	0x29, 0xed, 0xd2, 0x52, 0xa6, 0xf9, 0x2d, 0x0d, 0xf9, 0x76, 0xeb, 0xd4, 0x72, 0x30, 0x58, 0x72,
-	0x17, 0x49, 0x1b, 0xf2, 0x5b, 0x6b, 0x43, 0xf4, 0x78, 0xc1, 0xff, 0x58, 0xc3, 0xca, 0xd3, 0x04,
-	0x12, 0x35, 0x6e, 0xa6, 0x08, 0xc2, 0x7e, 0xf2, 0xb8, 0xc9, 0x8b, 0x28, 0xec, 0xde, 0xcd, 0xaa,
-	0x1c, 0xde, 0x7f, 0xe9, 0xba, 0xcc, 0x19, 0x14, 0x6e, 0x4f, 0x87, 0x1c, 0x44, 0x91, 0x89, 0x83,
-	0xaf, 0x3c, 0x4b, 0x86, 0x9b, 0x74, 0x27, 0xef, 0x7e, 0xac, 0x0c, 0xed, 0x6a, 0x65, 0x68, 0xbf,
-	0x56, 0x86, 0xf6, 0x7d, 0x6d, 0xa4, 0xae, 0xd6, 0x46, 0xea, 0xe7, 0xda, 0x48, 0x7d, 0x79, 0x39,
-	0xe6, 0x72, 0xb2, 0x18, 0xd6, 0x5d, 0x7f, 0xd6, 0x70, 0xd1, 0x43, 0x21, 0x39, 0xf3, 0x83, 0xb1,
-	0x7a, 0x45, 0x1a, 0xea, 0x99, 0x50, 0xe6, 0x30, 0xa7, 0xec, 0xd7, 0xbf, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0xf5, 0x33, 0x51, 0x92, 0x64, 0x04, 0x00, 0x00,
+	// 711 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x6f, 0xda, 0x58,
+	0x10, 0xc7, 0x81, 0x40, 0x18, 0x36, 0x40, 0x5e, 0xfe, 0x39, 0x24, 0xcb, 0x22, 0x6f, 0xb2, 0xcb,
+	0xe6, 0x00, 0x52, 0xf6, 0x52, 0xa9, 0x97, 0x12, 0x43, 0x5b, 0xd4, 0xa4, 0xa9, 0x6c, 0xb8, 0xf4,
+	0x62, 0x3d, 0xec, 0x09, 0x3c, 0xc5, 0xd8, 0xc4, 0xcf, 0x90, 0xe6, 0x4b, 0x54, 0xfd, 0x4e, 0xbd,
+	0xf4, 0x98, 0x63, 0x8f, 0x55, 0xf2, 0x45, 0x2a, 0x3f, 0x1b, 0x08, 0xa9, 0x1b, 0x29, 0xb9, 0xa0,
+	0x37, 0xbf, 0xdf, 0x9b, 0x99, 0xdf, 0x30, 0x33, 0xcf, 0x50, 0xb0, 0xa8, 0x6d, 0xd6, 0x83, 0x9f,
+	0xda, 0xc8, 0x73, 0x7d, 0x97, 0xa4, 0x82, 0x73, 0x69, 0xdb, 0x1d, 0xf9, 0x6c, 0xc8, 0x1c, 0xbf,
+	0x3e, 0x3d, 0x84, 0xb4, 0xf2, 0x09, 0xa0, 0xd9, 0xd0, 0x90, 0x8f, 0x5c, 0x87, 0x23, 0xd9, 0x87,
+	0x94, 0xe9, 0x5a, 0x28, 0x4b, 0x15, 0xa9, 0x9a, 0x3f, 0x2a, 0xd6, 0x44, 0x1c, 0xdd, 0xa7, 0xfe,
+	0x98, 0xab, 0xae, 0x85, 0x9a, 0x60, 0x89, 0x0c, 0x99, 0x21, 0x72, 0x4e, 0xfb, 0x28, 0x2f, 0x55,
+	0xa4, 0x6a, 0x56, 0x9b, 0x9a, 0xe4, 0x10, 0xd6, 0x2c, 0xea, 0x53, 0xc3, 0xa6, 0xd7, 0xe8, 0x19,
+	0x03, 0x64, 0xfd, 0x81, 0x2f, 0x27, 0x2b, 0x52, 0x35, 0xa5, 0x15, 0x02, 0xe2, 0x24, 0xc0, 0xdf,
+	0x0a, 0x58, 0x79, 0x09, 0x44, 0x1f, 0xf7, 0x86, 0xcc, 0x3f, 0xb6, 0x5d, 0xf3, 0x42, 0xc3, 0xcb,
+	0x31, 0x72, 0x9f, 0x1c, 0xc0, 0x72, 0x2f, 0xb0, 0x85, 0x84, 0xdc, 0x51, 0xa1, 0x36, 0xd3, 0x1b,
+	0x5e, 0x0b, 0x59, 0xe5, 0x1c, 0xd6, 0x17, 0x9c, 0x23, 0xfd, 0x55, 0x48, 0x7b, 0xc8, 0xc7, 0xb6,
+	0x1f, 0xb9, 0x47, 0x15, 0xcc, 0x2b, 0xd4, 0x22, 0x9e, 0x1c, 0x40, 0x9e, 0xb3, 0xbe, 0x83, 0x9e,
+	0x41, 0x2d, 0xcb, 0x43, 0xce, 0xa3, 0x52, 0x56, 0x43, 0xb4, 0x11, 0x82, 0xca, 0x3b, 0xf8, 0x53,
+	0x1d, 0xa0, 0x79, 0x21, 0xd2, 0x34, 0x26, 0x94, 0xd9, 0xb4, 0xc7, 0x6c, 0xe6, 0x5f, 0x4f, 0xf5,
+	0xc6, 0x56, 0x2c, 0xc5, 0x57, 0x7c, 0x09, 0xe5, 0xdf, 0x05, 0x7b, 0x8e, 0x7e, 0x91, 0x97, 0x86,
+	0x61, 0xec, 0xb0, 0x15, 0x2b, 0xda, 0x6a, 0x80, 0x36, 0xa6, 0xa0, 0xa2, 0xc2, 0xa6, 0x86, 0xbe,
+	0xc7, 0x70, 0x82, 0x22, 0x2b, 0x7f, 0x8e, 0xee, 0x0b, 0xd8, 0x7a, 0x18, 0xe4, 0xc9, 0x7a, 0xff,
+	0x85, 0xb4, 0xe8, 0x5c, 0xf0, 0x3f, 0x27, 0xe3, 0x1a, 0x1b, 0xd1, 0x4a, 0x11, 0xf2, 0x6f, 0xd0,
+	0x6f, 0x3b, 0xe7, 0x6e, 0x24, 0x55, 0xf9, 0x9c, 0x84, 0xc2, 0x0c, 0x7a, 0x72, 0x62, 0x19, 0x32,
+	0x13, 0xf4, 0x38, 0x73, 0x9d, 0xe9, 0xb0, 0x46, 0x26, 0xd9, 0x83, 0xac, 0x43, 0x87, 0xc8, 0x47,
+	0xd4, 0x44, 0x31, 0xa4, 0x7f, 0x68, 0x73, 0x80, 0xec, 0xc0, 0x8a, 0x39, 0xa0, 0xcc, 0x31, 0x98,
+	0x25, 0xa7, 0x42, 0x47, 0x61, 0xb7, 0xad, 0x98, 0xd9, 0x59, 0x8e, 0x99, 0x9d, 0x20, 0x73, 0x8f,
+	0xda, 0xd4, 0x31, 0x51, 0x4e, 0x87, 0x01, 0x22, 0x93, 0x6c, 0xc0, 0xb2, 0x85, 0x8e, 0x3b, 0x94,
+	0x33, 0x02, 0x0f, 0x8d, 0xf8, 0x96, 0xac, 0xc4, 0xb6, 0x84, 0xd4, 0x61, 0x7d, 0x84, 0x8e, 0xc5,
+	0x9c, 0xbe, 0xc1, 0x83, 0x3d, 0xe0, 0x41, 0x45, 0x5c, 0xce, 0x8a, 0xdb, 0x24, 0xa2, 0xf4, 0x39,
+	0x43, 0xfe, 0x82, 0x9c, 0xed, 0x5e, 0x19, 0x53, 0x41, 0x20, 0x86, 0x05, 0x6c, 0xf7, 0xea, 0x38,
+	0xd2, 0xf4, 0x1f, 0x14, 0x17, 0x8b, 0x42, 0x2e, 0xe7, 0x2a, 0xc9, 0x6a, 0x56, 0x2b, 0x2c, 0x94,
+	0x85, 0xfc, 0xd0, 0x03, 0x98, 0xbf, 0x09, 0x64, 0x17, 0xb6, 0xf5, 0x4e, 0xa3, 0xd3, 0xd5, 0x0d,
+	0xf5, 0xac, 0xd9, 0x32, 0xba, 0xef, 0xf5, 0x0f, 0x2d, 0xb5, 0xfd, 0xba, 0xdd, 0x6a, 0x16, 0x13,
+	0x64, 0x1b, 0xd6, 0xef, 0x93, 0x7a, 0x57, 0x55, 0x5b, 0xba, 0x5e, 0x94, 0x1e, 0x12, 0x9d, 0xf6,
+	0x69, 0xeb, 0xac, 0xdb, 0x29, 0x2e, 0x91, 0x4d, 0x58, 0xbb, 0x4f, 0xb4, 0x34, 0xed, 0x4c, 0x2b,
+	0x26, 0x8f, 0xbe, 0x2e, 0x41, 0xae, 0xd9, 0x38, 0x51, 0x75, 0xf4, 0x26, 0xcc, 0x44, 0xd2, 0x84,
+	0xdc, 0xbd, 0x07, 0x80, 0xc8, 0xd1, 0x53, 0xf5, 0xcb, 0x83, 0x52, 0xda, 0x89, 0x61, 0xc2, 0x11,
+	0x51, 0x12, 0x04, 0x61, 0x2b, 0x7e, 0x23, 0xc9, 0xdf, 0xa1, 0xdb, 0xa3, 0xcb, 0x5f, 0xda, 0x7f,
+	0xfc, 0xd2, 0x2c, 0xcd, 0x29, 0xe4, 0x17, 0x17, 0x88, 0xec, 0x86, 0x9e, 0xb1, 0xbb, 0x59, 0xda,
+	0x8b, 0x27, 0x67, 0xe1, 0x5e, 0x40, 0x26, 0xda, 0x07, 0xb2, 0x11, 0x5e, 0x5d, 0xdc, 0x98, 0xd2,
+	0xe6, 0x03, 0x74, 0xea, 0x79, 0xfc, 0xea, 0xdb, 0x6d, 0x59, 0xba, 0xb9, 0x2d, 0x4b, 0x3f, 0x6e,
+	0xcb, 0xd2, 0x97, 0xbb, 0x72, 0xe2, 0xe6, 0xae, 0x9c, 0xf8, 0x7e, 0x57, 0x4e, 0x7c, 0xfc, 0xa7,
+	0xcf, 0xfc, 0xc1, 0xb8, 0x57, 0x33, 0xdd, 0x61, 0xdd, 0x44, 0x1b, 0xb9, 0xcf, 0xa8, 0xeb, 0xf5,
+	0xc5, 0x97, 0xa4, 0x2e, 0x3e, 0x15, 0xe2, 0xd8, 0x4b, 0x8b, 0xf3, 0xff, 0x3f, 0x07, 0x00, 0x79,
+	0xb4, 0x67, 0xcb, 0x68, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -463,6 +659,7 @@ type DALCServiceClient interface {
 	SubmitBlock(ctx context.Context, in *SubmitBlockRequest, opts ...grpc.CallOption) (*SubmitBlockResponse, error)
 	CheckBlockAvailability(ctx context.Context, in *CheckBlockAvailabilityRequest, opts ...grpc.CallOption) (*CheckBlockAvailabilityResponse, error)
 	RetrieveBlocks(ctx context.Context, in *RetrieveBlocksRequest, opts ...grpc.CallOption) (*RetrieveBlocksResponse, error)
+	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
 }
 
 type dALCServiceClient struct {
@@ -500,11 +697,21 @@ func (c *dALCServiceClient) RetrieveBlocks(ctx context.Context, in *RetrieveBloc
 	return out, nil
 }
 
+func (c *dALCServiceClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
+	out := new(GetInfoResponse)
+	err := c.cc.Invoke(ctx, "/dalc.DALCService/GetInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DALCServiceServer is the server API for DALCService service.
 type DALCServiceServer interface {
 	SubmitBlock(context.Context, *SubmitBlockRequest) (*SubmitBlockResponse, error)
 	CheckBlockAvailability(context.Context, *CheckBlockAvailabilityRequest) (*CheckBlockAvailabilityResponse, error)
 	RetrieveBlocks(context.Context, *RetrieveBlocksRequest) (*RetrieveBlocksResponse, error)
+	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
 }
 
 // UnimplementedDALCServiceServer can be embedded to have forward compatible implementations.
@@ -520,6 +727,9 @@ func (*UnimplementedDALCServiceServer) CheckBlockAvailability(ctx context.Contex
 func (*UnimplementedDALCServiceServer) RetrieveBlocks(ctx context.Context, req *RetrieveBlocksRequest) (*RetrieveBlocksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RetrieveBlocks not implemented")
 }
+func (*UnimplementedDALCServiceServer) GetInfo(ctx context.Context, req *GetInfoRequest) (*GetInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
+}
 
 func RegisterDALCServiceServer(s *grpc.Server, srv DALCServiceServer) {
 	s.RegisterService(&_DALCService_serviceDesc, srv)
@@ -579,6 +789,24 @@ func _DALCService_RetrieveBlocks_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DALCService_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DALCServiceServer).GetInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dalc.DALCService/GetInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DALCServiceServer).GetInfo(ctx, req.(*GetInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DALCService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dalc.DALCService",
 	HandlerType: (*DALCServiceServer)(nil),
@@ -595,6 +823,10 @@ var _DALCService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RetrieveBlocks",
 			Handler:    _DALCService_RetrieveBlocks_Handler,
 		},
+		{
+			MethodName: "GetInfo",
+			Handler:    _DALCService_GetInfo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dalc/dalc.proto",
@@ -695,6 +927,13 @@ func (m *SubmitBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SignerAddress) > 0 {
+		i -= len(m.SignerAddress)
+		copy(dAtA[i:], m.SignerAddress)
+		i = encodeVarintDalc(dAtA, i, uint64(len(m.SignerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Result != nil {
 		{
 			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
@@ -860,6 +1099,135 @@ func (m *RetrieveBlocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *GetInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignerAddresses) > 0 {
+		for iNdEx := len(m.SignerAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SignerAddresses[iNdEx])
+			copy(dAtA[i:], m.SignerAddresses[iNdEx])
+			i = encodeVarintDalc(dAtA, i, uint64(len(m.SignerAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.LowBalance {
+		i--
+		if m.LowBalance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.PendingSubmissions != 0 {
+		i = encodeVarintDalc(dAtA, i, uint64(m.PendingSubmissions))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.DataLayerHeight != 0 {
+		i = encodeVarintDalc(dAtA, i, uint64(m.DataLayerHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintDalc(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintDalc(dAtA, i, uint64(len(m.Balance)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SignerAddress) > 0 {
+		i -= len(m.SignerAddress)
+		copy(dAtA[i:], m.SignerAddress)
+		i = encodeVarintDalc(dAtA, i, uint64(len(m.SignerAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintDalc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintDalc(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintDalc(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDalc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDalc(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDalc(v)
 	base := offset
@@ -913,6 +1281,10 @@ func (m *SubmitBlockResponse) Size() (n int) {
 		l = m.Result.Size()
 		n += 1 + l + sovDalc(uint64(l))
 	}
+	l = len(m.SignerAddress)
+	if l > 0 {
+		n += 1 + l + sovDalc(uint64(l))
+	}
 	return n
 }
 
@@ -975,46 +1347,107 @@ func (m *RetrieveBlocksResponse) Size() (n int) {
 	return n
 }
 
-func sovDalc(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDalc(x uint64) (n int) {
-	return sovDalc(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *GetInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *DAResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDalc
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DAResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
-			}
-			m.Code = 0
+
+func (m *GetInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovDalc(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovDalc(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovDalc(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovDalc(uint64(l))
+	}
+	l = len(m.SignerAddress)
+	if l > 0 {
+		n += 1 + l + sovDalc(uint64(l))
+	}
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovDalc(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovDalc(uint64(l))
+	}
+	if m.DataLayerHeight != 0 {
+		n += 1 + sovDalc(uint64(m.DataLayerHeight))
+	}
+	if m.PendingSubmissions != 0 {
+		n += 1 + sovDalc(uint64(m.PendingSubmissions))
+	}
+	if m.LowBalance {
+		n += 2
+	}
+	if len(m.SignerAddresses) > 0 {
+		for _, s := range m.SignerAddresses {
+			l = len(s)
+			n += 1 + l + sovDalc(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovDalc(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDalc(x uint64) (n int) {
+	return sovDalc(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DAResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDalc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DAResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDalc
@@ -1252,6 +1685,38 @@ func (m *SubmitBlockResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDalc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDalc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDalc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDalc(dAtA[iNdEx:])
@@ -1637,6 +2102,426 @@ func (m *RetrieveBlocksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDalc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDalc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDalc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDalc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDalc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDalc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDalc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &DAResponse{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDalc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDalc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDalc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDalc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDalc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDalc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDalc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDalc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDalc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDalc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDalc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDalc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDalc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDalc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDalc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDalc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDalc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDalc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataLayerHeight", wireType)
+			}
+			m.DataLayerHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDalc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataLayerHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSubmissions", wireType)
+			}
+			m.PendingSubmissions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDalc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingSubmissions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowBalance", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDalc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LowBalance = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDalc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDalc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDalc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerAddresses = append(m.SignerAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDalc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDalc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDalc(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+
+	"github.com/celestiaorg/dalc/config"
+)
+
+// dialApp connects to the grpc endpoint of the celestia-app node at addr. The
+// connection is established lazily and re-established with exponential
+// backoff whenever it is lost.
+func dialApp(addr string, cfg config.AppConnConfig) (*grpc.ClientConn, error) {
+	defaults := config.DefaultAppConnConfig()
+
+	params := grpc.ConnectParams{
+		Backoff:           backoff.DefaultConfig,
+		MinConnectTimeout: orDefault(cfg.MinConnectTimeout, defaults.MinConnectTimeout),
+	}
+	params.Backoff.BaseDelay = orDefault(cfg.ReconnectBaseDelay, defaults.ReconnectBaseDelay)
+	params.Backoff.MaxDelay = orDefault(cfg.ReconnectMaxDelay, defaults.ReconnectMaxDelay)
+	opts := []grpc.DialOption{
+		grpc.WithConnectParams(params),
+		// propagate the traces of submissions to celestia-app
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+	}
+
+	if cfg.KeepaliveTime > 0 {
+		opts = append(opts, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:    cfg.KeepaliveTime,
+			Timeout: orDefault(cfg.KeepaliveTimeout, defaults.KeepaliveTimeout),
+		}))
+	}
+
+	if cfg.TLSEnabled() {
+		tlsCfg, err := appTLSConfig(addr, cfg)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsCfg)))
+	} else {
+		log.Warnw("connecting to celestia-app without tls, transactions are not encrypted", "address", addr)
+		opts = append(opts, grpc.WithInsecure())
+	}
+
+	return grpc.Dial(addr, opts...)
+}
+
+// appTLSConfig returns the TLS configuration used to connect to the
+// celestia-app node at addr
+func appTLSConfig(addr string, cfg config.AppConnConfig) (*tls.Config, error) {
+	tlsCfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: cfg.ServerName,
+	}
+	if tlsCfg.ServerName == "" {
+		host, _, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, fmt.Errorf("parsing celestia-app address: %w", err)
+		}
+		tlsCfg.ServerName = host
+	}
+
+	// a nil pool uses the system roots
+	if cfg.CAFile != "" {
+		pool, err := loadCertPool(cfg.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsCfg.RootCAs = pool
+	}
+
+	if cfg.CertFile != "" || cfg.KeyFile != "" {
+		if cfg.CertFile == "" || cfg.KeyFile == "" {
+			return nil, errors.New("both cert-file and key-file are required for a celestia-app client certificate")
+		}
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading celestia-app client certificate: %w", err)
+		}
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsCfg, nil
+}
+
+func orDefault(d, def time.Duration) time.Duration {
+	if d <= 0 {
+		return def
+	}
+	return d
+}
@@ -0,0 +1,60 @@
+package server
+
+import (
+	"crypto/tls"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/dalc/config"
+)
+
+func TestAppTLSConfig(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	caFile := filepath.Join(dir, "ca.crt")
+	writePEM(t, caFile, "CERTIFICATE", ca.cert.Raw)
+
+	// the celestia-app node requires a client certificate signed by the CA
+	addr := serveTLS(t, &tls.Config{
+		Certificates: []tls.Certificate{ca.issue(t, 1)},
+		ClientCAs:    ca.pool(),
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MaxVersion:   tls.VersionTLS12,
+	})
+	_, port, err := net.SplitHostPort(addr)
+	require.NoError(t, err)
+	addr = "localhost:" + port
+
+	cfg := config.AppConnConfig{CAFile: caFile}
+	assert.True(t, cfg.TLSEnabled())
+
+	tlsCfg, err := appTLSConfig(addr, cfg)
+	require.NoError(t, err)
+	assert.Equal(t, "localhost", tlsCfg.ServerName)
+	_, err = tls.Dial("tcp", addr, tlsCfg)
+	assert.Error(t, err, "the client certificate is missing")
+
+	cfg.CertFile = filepath.Join(dir, "client.crt")
+	cfg.KeyFile = filepath.Join(dir, "client.key")
+	ca.writeCert(t, cfg.CertFile, cfg.KeyFile, 2)
+
+	tlsCfg, err = appTLSConfig(addr, cfg)
+	require.NoError(t, err)
+	conn, err := tls.Dial("tcp", addr, tlsCfg)
+	require.NoError(t, err)
+	conn.Close()
+
+	cfg.KeyFile = ""
+	_, err = appTLSConfig(addr, cfg)
+	assert.Error(t, err)
+}
+
+func TestDialAppInsecure(t *testing.T) {
+	conn, err := dialApp("127.0.0.1:9090", config.AppConnConfig{})
+	require.NoError(t, err)
+	assert.NoError(t, conn.Close())
+}
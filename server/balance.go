@@ -0,0 +1,239 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celestiaorg/dalc/config"
+)
+
+// ErrInsufficientFunds is returned when the signer accounts can not pay the fee
+// of a submission
+var ErrInsufficientFunds = errors.New("dalc: signer balance can not cover the submission fee")
+
+// balanceMonitor periodically queries the balances of the signer accounts,
+// records them and warns when an account runs low
+type balanceMonitor struct {
+	query    func(ctx context.Context) ([]accountState, error)
+	interval time.Duration
+	metrics  *metrics
+
+	// mtx guards the balances and the fee and threshold they are compared to,
+	// which are changed when the config is reloaded
+	mtx      sync.RWMutex
+	fee      sdk.Coin
+	low      sdk.Coin
+	balances map[string]sdk.Coin
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newBalanceMonitor(bs blockSubmitter, cfg config.BalanceConfig, m *metrics) *balanceMonitor {
+	interval := cfg.CheckInterval
+	if interval <= 0 {
+		interval = config.DefaultBalanceConfig().CheckInterval
+	}
+	fee := bs.fee()
+	return &balanceMonitor{
+		query:    bs.accountStates,
+		fee:      fee,
+		low:      sdk.NewCoin(fee.Denom, sdk.NewIntFromUint64(cfg.LowThreshold)),
+		interval: interval,
+		metrics:  m,
+	}
+}
+
+// start checks the balances in the background until stop is called
+func (bm *balanceMonitor) start() {
+	if bm == nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	bm.cancel = cancel
+	bm.done = make(chan struct{})
+
+	go func() {
+		defer close(bm.done)
+		ticker := time.NewTicker(bm.interval)
+		defer ticker.Stop()
+		for {
+			err := bm.check(ctx)
+			if err != nil && ctx.Err() == nil {
+				log.Warnw("checking signer balance", "err", err)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (bm *balanceMonitor) stop() {
+	if bm != nil && bm.cancel != nil {
+		bm.cancel()
+		<-bm.done
+	}
+}
+
+// check queries the balance and sequence of the signer accounts and replaces
+// the balances known for each of them
+func (bm *balanceMonitor) check(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, bm.interval)
+	defer cancel()
+
+	states, err := bm.query(ctx)
+	if err != nil {
+		return err
+	}
+	if len(states) == 0 {
+		return nil
+	}
+	balances := make(map[string]sdk.Coin, len(states))
+	for _, state := range states {
+		bm.metrics.observeAccount(state)
+		balances[state.name] = state.balance
+	}
+
+	bm.mtx.Lock()
+	bm.balances = balances
+	fee, low := bm.fee, bm.low
+	bm.mtx.Unlock()
+	for _, state := range states {
+		warnBalance(state.name, state.balance, fee, low)
+	}
+	return nil
+}
+
+// lowestBalance returns the lowest balance of the accounts
+func lowestBalance(states []accountState) sdk.Coin {
+	lowest := states[0].balance
+	for _, state := range states[1:] {
+		if state.balance.IsLT(lowest) {
+			lowest = state.balance
+		}
+	}
+	return lowest
+}
+
+// spend deducts a fee paid by a submission from the last known balance of the
+// account that signed it, until the next check
+func (bm *balanceMonitor) spend(name string, fee sdk.Coin) {
+	if bm == nil {
+		return
+	}
+	bm.mtx.Lock()
+	known, ok := bm.balances[name]
+	if !ok || known.Denom != fee.Denom {
+		bm.mtx.Unlock()
+		return
+	}
+	balance := sdk.NewCoin(fee.Denom, sdk.ZeroInt())
+	if !known.IsLT(fee) {
+		balance = known.Sub(fee)
+	}
+	bm.balances[name] = balance
+	fee, low := bm.fee, bm.low
+	bm.mtx.Unlock()
+	warnBalance(name, balance, fee, low)
+}
+
+// warnBalance logs the balance of an account if it is below the threshold or
+// can not cover the fee
+func warnBalance(name string, balance, fee, low sdk.Coin) {
+	if balance.IsLT(fee) {
+		log.Errorw("signer balance can not cover the submission fee, submissions signed by the account will fail",
+			"account", name, "balance", balance, "fee", fee)
+	} else if balance.IsLT(low) {
+		log.Warnw("signer balance is low", "account", name, "balance", balance, "threshold", low)
+	}
+}
+
+// setLimits changes the fee and the threshold the balances are compared to
+func (bm *balanceMonitor) setLimits(fee sdk.Coin, lowThreshold uint64) {
+	if bm == nil {
+		return
+	}
+	bm.mtx.Lock()
+	defer bm.mtx.Unlock()
+	bm.fee = fee
+	bm.low = sdk.NewCoin(fee.Denom, sdk.NewIntFromUint64(lowThreshold))
+}
+
+// Balance returns the last known balance of the poorest signer account, or
+// false if it was not checked yet
+func (bm *balanceMonitor) Balance() (sdk.Coin, bool) {
+	if bm == nil {
+		return sdk.Coin{}, false
+	}
+	bm.mtx.RLock()
+	defer bm.mtx.RUnlock()
+	return bm.lowest()
+}
+
+// lowest returns the lowest known balance. mtx must be held.
+func (bm *balanceMonitor) lowest() (sdk.Coin, bool) {
+	var lowest sdk.Coin
+	checked := false
+	for _, balance := range bm.balances {
+		if !checked || balance.IsLT(lowest) {
+			lowest = balance
+			checked = true
+		}
+	}
+	return lowest, checked
+}
+
+// Low returns true if the last known balance of any signer account is below
+// the configured threshold or can not cover the fee
+func (bm *balanceMonitor) Low() bool {
+	if bm == nil {
+		return false
+	}
+	bm.mtx.RLock()
+	defer bm.mtx.RUnlock()
+	lowest, ok := bm.lowest()
+	return ok && (lowest.IsLT(bm.fee) || lowest.IsLT(bm.low))
+}
+
+// canPay returns ErrInsufficientFunds if the last known balance of the account
+// can not cover the fee of a submission. Unchecked balances are assumed to be
+// sufficient.
+func (bm *balanceMonitor) canPay(name string) error {
+	if bm == nil {
+		return nil
+	}
+	bm.mtx.RLock()
+	defer bm.mtx.RUnlock()
+	balance, ok := bm.balances[name]
+	if ok && balance.IsLT(bm.fee) {
+		return fmt.Errorf("%w: balance of %s is %s, fee is %s", ErrInsufficientFunds, name, balance, bm.fee)
+	}
+	return nil
+}
+
+// anyCanPay returns ErrInsufficientFunds if none of the signer accounts can
+// cover the fee of a submission according to their last known balance
+func (bm *balanceMonitor) anyCanPay() error {
+	if bm == nil {
+		return nil
+	}
+	bm.mtx.RLock()
+	defer bm.mtx.RUnlock()
+	if len(bm.balances) == 0 {
+		return nil
+	}
+	for _, balance := range bm.balances {
+		if !balance.IsLT(bm.fee) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: no signer account holds %s", ErrInsufficientFunds, bm.fee)
+}
@@ -0,0 +1,157 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/celestiaorg/dalc/config"
+	"github.com/celestiaorg/dalc/proto/dalc"
+)
+
+func testBalanceMonitor(balance int64) *balanceMonitor {
+	return &balanceMonitor{
+		query: func(ctx context.Context) ([]accountState, error) {
+			return []accountState{
+				{name: "rich", balance: sdk.NewInt64Coin("celes", balance+10000), sequence: 1},
+				{name: "poor", balance: sdk.NewInt64Coin("celes", balance), sequence: 1},
+			}, nil
+		},
+		fee:      sdk.NewInt64Coin("celes", 100),
+		low:      sdk.NewInt64Coin("celes", 1000),
+		interval: time.Millisecond * 10,
+	}
+}
+
+func TestBalanceMonitor(t *testing.T) {
+	ctx := context.Background()
+	bm := testBalanceMonitor(1100)
+
+	// balances are assumed to be sufficient until they are checked
+	_, ok := bm.Balance()
+	assert.False(t, ok)
+	assert.False(t, bm.Low())
+	assert.NoError(t, bm.canPay("poor"))
+	assert.NoError(t, bm.anyCanPay())
+
+	// the balance of the poorest account is reported
+	require.NoError(t, bm.check(ctx))
+	balance, ok := bm.Balance()
+	require.True(t, ok)
+	assert.Equal(t, sdk.NewInt64Coin("celes", 1100), balance)
+	assert.False(t, bm.Low())
+
+	// fees are deducted from the account that paid them
+	bm.spend("rich", sdk.NewInt64Coin("celes", 500))
+	assert.Equal(t, sdk.NewInt64Coin("celes", 10600), bm.balances["rich"])
+	assert.Equal(t, sdk.NewInt64Coin("celes", 1100), bm.balances["poor"])
+	assert.False(t, bm.Low())
+
+	bm.spend("poor", sdk.NewInt64Coin("celes", 200))
+	balance, _ = bm.Balance()
+	assert.Equal(t, sdk.NewInt64Coin("celes", 900), balance)
+	assert.True(t, bm.Low())
+	assert.NoError(t, bm.canPay("poor"))
+
+	bm.spend("poor", sdk.NewInt64Coin("celes", 850))
+	balance, _ = bm.Balance()
+	assert.Equal(t, sdk.NewInt64Coin("celes", 50), balance)
+	assert.ErrorIs(t, bm.canPay("poor"), ErrInsufficientFunds)
+	assert.NoError(t, bm.canPay("rich"))
+	assert.NoError(t, bm.anyCanPay())
+
+	// spending more than the known balance does not make it negative
+	bm.spend("poor", sdk.NewInt64Coin("celes", 100))
+	balance, _ = bm.Balance()
+	assert.True(t, balance.IsZero())
+
+	bm.spend("rich", sdk.NewInt64Coin("celes", 10600))
+	assert.ErrorIs(t, bm.anyCanPay(), ErrInsufficientFunds)
+
+	// the next check replaces the estimates
+	require.NoError(t, bm.check(ctx))
+	balance, _ = bm.Balance()
+	assert.Equal(t, sdk.NewInt64Coin("celes", 1100), balance)
+	assert.Equal(t, sdk.NewInt64Coin("celes", 11100), bm.balances["rich"])
+}
+
+func TestBalanceMonitorStart(t *testing.T) {
+	bm := testBalanceMonitor(1100)
+	queried := make(chan struct{}, 1)
+	query := bm.query
+	bm.query = func(ctx context.Context) ([]accountState, error) {
+		select {
+		case queried <- struct{}{}:
+		default:
+		}
+		return query(ctx)
+	}
+
+	bm.start()
+	<-queried
+	bm.stop()
+
+	_, ok := bm.Balance()
+	assert.True(t, ok)
+
+	bm.query = func(ctx context.Context) ([]accountState, error) {
+		return nil, errors.New("unreachable")
+	}
+	assert.Error(t, bm.check(context.Background()))
+}
+
+func TestSubmitBlockRefuseUnfunded(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.DefaultBlockSubmitterConfig()
+	cfg.KeyringAccNames = []string{"poor", "rich"}
+	cfg.FeeAmount = 100
+	bs, err := newBlockSubmitter(cfg, testAppConn(t, &includingTxService{}), generateKeyring(t, cfg.KeyringAccNames...))
+	require.NoError(t, err)
+	for _, acc := range bs.signers.accounts {
+		acc.synced = true
+	}
+
+	srv := testServer(t, &staticRetriever{}, time.Second)
+	srv.lc.blockSubmitter = bs
+	srv.lc.balance = testBalanceMonitor(50)
+	srv.lc.refuseUnfunded = true
+	require.NoError(t, srv.lc.balance.check(ctx))
+
+	// the account that can not pay is skipped
+	richAddress, err := bs.signers.accounts[1].address()
+	require.NoError(t, err)
+	resp, err := srv.lc.SubmitBlock(ctx, &dalc.SubmitBlockRequest{Block: generateOptmintBlock(1, srv.lc.namespace)})
+	require.NoError(t, err)
+	assert.Equal(t, richAddress, resp.SignerAddress)
+	assert.Equal(t, sdk.NewInt64Coin("celes", 9950), srv.lc.balance.balances["rich"])
+	assert.Equal(t, sdk.NewInt64Coin("celes", 50), srv.lc.balance.balances["poor"])
+
+	// submissions are refused once no account can pay
+	srv.lc.balance.spend("rich", sdk.NewInt64Coin("celes", 9900))
+	resp, err = srv.lc.SubmitBlock(ctx, &dalc.SubmitBlockRequest{Block: generateOptmintBlock(2, srv.lc.namespace)})
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, dalc.StatusCode_STATUS_CODE_ERROR, resp.Result.Code)
+	assert.Contains(t, resp.Result.Message, "can not cover the submission fee")
+	poorAddress, err := bs.signers.accounts[0].address()
+	require.NoError(t, err)
+	assert.Equal(t, poorAddress, resp.SignerAddress)
+	assert.Zero(t, srv.lc.pendingCount())
+}
+
+// includingTxService includes every broadcast transaction in a block
+type includingTxService struct {
+	tx.UnimplementedServiceServer
+}
+
+func (its *includingTxService) BroadcastTx(ctx context.Context, req *tx.BroadcastTxRequest) (*tx.BroadcastTxResponse, error) {
+	return &tx.BroadcastTxResponse{TxResponse: &sdk.TxResponse{Height: 1}}, nil
+}
@@ -0,0 +1,250 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/celestiaorg/dalc/auth"
+	"github.com/celestiaorg/dalc/proto/dalc"
+)
+
+// gatewayMaxBodySize is the size limit of the request bodies, which is the
+// default limit of the messages received by the grpc server
+const gatewayMaxBodySize = 4 << 20
+
+const (
+	gatewayBlocksPath       = "/v1/blocks"
+	gatewayAvailabilityPath = "/v1/availability/"
+	gatewayInfoPath         = "/v1/info"
+)
+
+// gateway serves the DALCService over http with JSON bodies, for tooling that
+// does not speak grpc. Bytes are encoded as base64.
+//
+//	POST /v1/blocks                  SubmitBlock, with a SubmitBlockRequest body
+//	GET  /v1/blocks/{height}         RetrieveBlocks
+//	GET  /v1/availability/{height}   CheckBlockAvailability
+//	GET  /v1/info                    GetInfo
+type gateway struct {
+	svc dalc.DALCServiceServer
+	// secret verifies the bearer tokens of the requests. Authorization is
+	// disabled if nil
+	secret []byte
+
+	marshaler   jsonpb.Marshaler
+	unmarshaler jsonpb.Unmarshaler
+}
+
+func newGateway(svc dalc.DALCServiceServer, secret []byte) http.Handler {
+	gw := &gateway{
+		svc:         svc,
+		secret:      secret,
+		marshaler:   jsonpb.Marshaler{EmitDefaults: true},
+		unmarshaler: jsonpb.Unmarshaler{},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(gatewayBlocksPath, gw.submitBlock)
+	mux.HandleFunc(gatewayBlocksPath+"/", gw.retrieveBlocks)
+	mux.HandleFunc(gatewayAvailabilityPath, gw.checkBlockAvailability)
+	mux.HandleFunc(gatewayInfoPath, gw.getInfo)
+	return mux
+}
+
+func (gw *gateway) submitBlock(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		gw.writeError(w, status.Error(codes.Unimplemented, "method not allowed"))
+		return
+	}
+	ctx, span, err := gw.begin(r, "SubmitBlock")
+	defer func() { endSpan(span, err) }()
+	if err != nil {
+		gw.writeError(w, err)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, gatewayMaxBodySize))
+	if err != nil {
+		if len(body) == gatewayMaxBodySize {
+			err = status.Errorf(codes.ResourceExhausted, "request body larger than %d bytes", gatewayMaxBodySize)
+			gw.writeErrorStatus(w, http.StatusRequestEntityTooLarge, err)
+			return
+		}
+		err = status.Errorf(codes.InvalidArgument, "reading request: %s", err)
+		gw.writeError(w, err)
+		return
+	}
+
+	var req dalc.SubmitBlockRequest
+	err = gw.unmarshaler.Unmarshal(bytes.NewReader(body), &req)
+	if err != nil {
+		err = status.Errorf(codes.InvalidArgument, "decoding request: %s", err)
+		gw.writeError(w, err)
+		return
+	}
+
+	resp, err := gw.svc.SubmitBlock(ctx, &req)
+	gw.write(w, resp, err)
+}
+
+func (gw *gateway) retrieveBlocks(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		gw.writeError(w, status.Error(codes.Unimplemented, "method not allowed"))
+		return
+	}
+	ctx, span, err := gw.begin(r, "RetrieveBlocks")
+	defer func() { endSpan(span, err) }()
+	if err != nil {
+		gw.writeError(w, err)
+		return
+	}
+
+	height, err := parseHeight(r.URL.Path, gatewayBlocksPath+"/")
+	if err != nil {
+		gw.writeError(w, err)
+		return
+	}
+
+	resp, err := gw.svc.RetrieveBlocks(ctx, &dalc.RetrieveBlocksRequest{DataLayerHeight: height})
+	gw.write(w, resp, err)
+}
+
+func (gw *gateway) checkBlockAvailability(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		gw.writeError(w, status.Error(codes.Unimplemented, "method not allowed"))
+		return
+	}
+	ctx, span, err := gw.begin(r, "CheckBlockAvailability")
+	defer func() { endSpan(span, err) }()
+	if err != nil {
+		gw.writeError(w, err)
+		return
+	}
+
+	height, err := parseHeight(r.URL.Path, gatewayAvailabilityPath)
+	if err != nil {
+		gw.writeError(w, err)
+		return
+	}
+
+	resp, err := gw.svc.CheckBlockAvailability(ctx, &dalc.CheckBlockAvailabilityRequest{DataLayerHeight: height})
+	gw.write(w, resp, err)
+}
+
+func (gw *gateway) getInfo(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		gw.writeError(w, status.Error(codes.Unimplemented, "method not allowed"))
+		return
+	}
+	ctx, span, err := gw.begin(r, "GetInfo")
+	defer func() { endSpan(span, err) }()
+	if err != nil {
+		gw.writeError(w, err)
+		return
+	}
+
+	resp, err := gw.svc.GetInfo(ctx, &dalc.GetInfoRequest{})
+	gw.write(w, resp, err)
+}
+
+// begin continues the trace of the request and authorizes it as if it called
+// the grpc method of the same name
+func (gw *gateway) begin(r *http.Request, method string) (context.Context, trace.Span, error) {
+	ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+	ctx, span := tracer.Start(ctx, "gateway/"+method, trace.WithSpanKind(trace.SpanKindServer))
+
+	if gw.secret == nil {
+		return ctx, span, nil
+	}
+	md := metadata.MD{}
+	if token := r.Header.Get("Authorization"); token != "" {
+		md.Set("authorization", token)
+	}
+	err := auth.Authorize(metadata.NewIncomingContext(ctx, md), gw.secret, "/dalc.DALCService/"+method)
+	return ctx, span, err
+}
+
+func (gw *gateway) write(w http.ResponseWriter, resp proto.Message, err error) {
+	if err != nil {
+		gw.writeError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	err = gw.marshaler.Marshal(w, resp)
+	if err != nil {
+		log.Errorw("writing gateway response", "err", err)
+	}
+}
+
+// gatewayError is the body of the responses to failed requests
+type gatewayError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (gw *gateway) writeError(w http.ResponseWriter, err error) {
+	gw.writeErrorStatus(w, httpStatus(status.Code(err)), err)
+}
+
+// writeErrorStatus writes err with the provided http status code instead of
+// the one mapped from its grpc status code
+func (gw *gateway) writeErrorStatus(w http.ResponseWriter, code int, err error) {
+	st := status.Convert(err)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	err = json.NewEncoder(w).Encode(gatewayError{Code: st.Code().String(), Message: st.Message()})
+	if err != nil {
+		log.Errorw("writing gateway error", "err", err)
+	}
+}
+
+func parseHeight(path, prefix string) (uint64, error) {
+	height, err := strconv.ParseUint(strings.TrimPrefix(path, prefix), 10, 64)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid height in %s", path)
+	}
+	return height, nil
+}
+
+// httpStatus maps grpc status codes to http status codes
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.Unimplemented:
+		return http.StatusMethodNotAllowed
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.Canceled:
+		return 499
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.FailedPrecondition, codes.Aborted, codes.AlreadyExists:
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
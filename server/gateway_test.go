@@ -0,0 +1,93 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coretypes "github.com/tendermint/tendermint/types"
+
+	"github.com/celestiaorg/dalc/auth"
+	"github.com/celestiaorg/dalc/proto/dalc"
+)
+
+func TestGateway(t *testing.T) {
+	ns := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	block := generateOptmintBlock(1, ns)
+	rawBlock, err := block.Marshal()
+	require.NoError(t, err)
+	msgs := coretypes.Messages{MessagesList: []coretypes.Message{{NamespaceID: ns, Data: rawBlock}}}
+	srv := testServer(t, &staticRetriever{shares: msgs.SplitIntoShares().RawShares()}, time.Second)
+
+	secret := []byte("secret")
+	token, err := auth.NewToken(secret, []auth.Permission{auth.PermRead}, 0)
+	require.NoError(t, err)
+
+	gw := httptest.NewServer(newGateway(srv.lc, secret))
+	t.Cleanup(gw.Close)
+
+	get := func(path, token string) *http.Response {
+		req, err := http.NewRequest(http.MethodGet, gw.URL+path, nil)
+		require.NoError(t, err)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	resp := get("/v1/blocks/1", token)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var blocks dalc.RetrieveBlocksResponse
+	require.NoError(t, jsonpb.Unmarshal(resp.Body, &blocks))
+	require.Len(t, blocks.Blocks, 1)
+	assert.True(t, proto.Equal(block, blocks.Blocks[0]))
+
+	resp = get("/v1/availability/1", token)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var avail dalc.CheckBlockAvailabilityResponse
+	require.NoError(t, jsonpb.Unmarshal(resp.Body, &avail))
+	assert.True(t, avail.DataAvailable)
+
+	resp = get("/v1/blocks/1", "")
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	var gwErr gatewayError
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&gwErr))
+	assert.Equal(t, "Unauthenticated", gwErr.Code)
+
+	resp = get("/v1/blocks/latest", token)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	// a read token can not submit blocks
+	req, err := http.NewRequest(http.MethodPost, gw.URL+"/v1/blocks", strings.NewReader(`{"block": {}}`))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+token)
+	postResp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	postResp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, postResp.StatusCode)
+}
+
+func TestGatewayBodyLimit(t *testing.T) {
+	srv := testServer(t, &staticRetriever{}, time.Second)
+	gw := httptest.NewServer(newGateway(srv.lc, nil))
+	t.Cleanup(gw.Close)
+
+	body := `{"block": {"data": {"txs": ["` + strings.Repeat("A", gatewayMaxBodySize) + `"]}}}`
+	resp, err := http.Post(gw.URL+"/v1/blocks", "application/json", strings.NewReader(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode)
+	var gwErr gatewayError
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&gwErr))
+	assert.Equal(t, "ResourceExhausted", gwErr.Code)
+}
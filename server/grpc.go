@@ -0,0 +1,234 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/multierr"
+	"google.golang.org/grpc"
+
+	"github.com/celestiaorg/dalc/config"
+)
+
+// Server serves the DALCService to optimint over grpc
+type Server struct {
+	srv *grpc.Server
+	lc  *DataAvailabilityLightClient
+
+	listenAddr      string
+	shutdownTimeout time.Duration
+
+	// endpoints are the http servers started and stopped with the grpc
+	// server, such as the metrics and the gateway
+	endpoints []*httpEndpoint
+	// health reports the readiness of the dalc, if set
+	health *healthChecker
+	// shutdownTracing flushes the spans that were not exported yet
+	shutdownTracing func(context.Context) error
+
+	// cfg is the running config, whose reloadable fields are replaced by
+	// Reload
+	cfg       config.ServerConfig
+	reloadMtx sync.Mutex
+	// watcher reloads the config when its file changes, if set
+	watcher *configWatcher
+
+	mtx      sync.Mutex
+	listener net.Listener
+	// serveErr receives the error that caused the server to stop serving, if
+	// it was not stopped on purpose
+	serveErr chan error
+	err      error
+	wg       sync.WaitGroup
+}
+
+// httpEndpoint is an http server served next to the grpc server
+type httpEndpoint struct {
+	name string
+	addr string
+	srv  *http.Server
+	// tlsConfig serves the endpoint over TLS if set
+	tlsConfig *tls.Config
+
+	listener net.Listener
+}
+
+func newGRPCServer(srv *grpc.Server, lc *DataAvailabilityLightClient, listenAddr string, shutdownTimeout time.Duration) *Server {
+	return &Server{
+		srv:             srv,
+		lc:              lc,
+		listenAddr:      listenAddr,
+		shutdownTimeout: shutdownTimeout,
+		serveErr:        make(chan error, 1),
+	}
+}
+
+// Start starts listening on the configured address and serving requests in the
+// background. Failures to serve after Start returned are reported through Err.
+func (s *Server) Start(ctx context.Context) error {
+	for i, ep := range s.endpoints {
+		lis, err := net.Listen("tcp", ep.addr)
+		if err != nil {
+			for _, started := range s.endpoints[:i] {
+				started.listener.Close()
+			}
+			return fmt.Errorf("dalc: listening for %s on %s: %w", ep.name, ep.addr, err)
+		}
+		if ep.tlsConfig != nil {
+			lis = tls.NewListener(lis, ep.tlsConfig)
+		}
+		ep.listener = lis
+	}
+
+	lis, err := net.Listen("tcp", s.listenAddr)
+	if err != nil {
+		for _, ep := range s.endpoints {
+			ep.listener.Close()
+		}
+		return fmt.Errorf("dalc: listening on %s: %w", s.listenAddr, err)
+	}
+
+	s.mtx.Lock()
+	s.listener = lis
+	s.mtx.Unlock()
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		err := s.srv.Serve(lis)
+		if err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			s.fail(fmt.Errorf("dalc: serving grpc on %s: %w", s.listenAddr, err))
+		}
+	}()
+	log.Infow("serving dalc", "address", lis.Addr().String())
+
+	for _, ep := range s.endpoints {
+		s.wg.Add(1)
+		go func(ep *httpEndpoint) {
+			defer s.wg.Done()
+			err := ep.srv.Serve(ep.listener)
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				s.fail(fmt.Errorf("dalc: serving %s on %s: %w", ep.name, ep.addr, err))
+			}
+		}(ep)
+		log.Infow("serving dalc "+ep.name, "address", ep.listener.Addr().String())
+	}
+
+	err = s.lc.Start(ctx)
+	if err != nil {
+		return err
+	}
+	if s.health != nil {
+		s.health.start()
+	}
+	s.startWatching()
+
+	go func() {
+		s.wg.Wait()
+		close(s.serveErr)
+	}()
+	return nil
+}
+
+// serveHTTP makes the server serve handler on addr, over TLS if tlsConfig is
+// not nil
+func (s *Server) serveHTTP(name, addr string, handler http.Handler, tlsConfig *tls.Config) {
+	s.endpoints = append(s.endpoints, &httpEndpoint{
+		name:      name,
+		addr:      addr,
+		srv:       &http.Server{Handler: handler, ReadHeaderTimeout: time.Second * 10},
+		tlsConfig: tlsConfig,
+	})
+}
+
+// fail records an error that made the server stop serving
+func (s *Server) fail(err error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.err = multierr.Append(s.err, err)
+	select {
+	case s.serveErr <- err:
+	default:
+	}
+}
+
+// Err returns a channel that receives the error which caused the server to
+// stop serving unexpectedly. The channel is closed once the server stops
+// serving.
+func (s *Server) Err() <-chan error {
+	return s.serveErr
+}
+
+// Addr returns the address the server is listening on, or nil if it was not
+// started yet
+func (s *Server) Addr() net.Addr {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.listener == nil {
+		return nil
+	}
+	return s.listener.Addr()
+}
+
+// Stop stops accepting new requests and waits for the in-flight ones to
+// complete. If they do not complete within the configured shutdown timeout or
+// before the context is done, they are cancelled and the blocks that were being
+// submitted are reported. Stop also returns the error that made the server stop
+// serving, if any.
+func (s *Server) Stop(ctx context.Context) error {
+	if s.shutdownTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.shutdownTimeout)
+		defer cancel()
+	}
+
+	s.stopWatching()
+
+	// report the dalc as not serving so that no new requests are routed to it
+	if s.health != nil {
+		s.health.stop()
+	}
+
+	var err error
+	for _, ep := range s.endpoints {
+		if ep.listener == nil {
+			continue
+		}
+		if shutdownErr := ep.srv.Shutdown(ctx); shutdownErr != nil {
+			err = multierr.Append(err, ep.srv.Close())
+		}
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		s.srv.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		log.Warnw("in-flight requests did not complete in time, stopping dalc forcefully", "err", ctx.Err())
+		// the submissions stop being tracked once their requests are
+		// cancelled, so they are recorded as interrupted beforehand
+		s.lc.interruptSubmissions()
+		s.srv.Stop()
+		<-stopped
+	}
+
+	// report the submissions that were interrupted
+	err = multierr.Append(err, s.lc.Stop(ctx))
+
+	if s.shutdownTracing != nil {
+		err = multierr.Append(err, s.shutdownTracing(ctx))
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return multierr.Combine(err, s.err)
+}
@@ -0,0 +1,155 @@
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/nmt/namespace"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/celestiaorg/dalc/config"
+	"github.com/celestiaorg/dalc/proto/dalc"
+)
+
+func TestServerStop(t *testing.T) {
+	ctx := context.Background()
+	retriever := &blockingRetriever{called: make(chan struct{})}
+	srv := testServer(t, retriever, time.Millisecond*100)
+	require.NoError(t, srv.Start(ctx))
+
+	conn, err := grpc.Dial(srv.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	reqErr := make(chan error, 1)
+	go func() {
+		_, err := dalc.NewDALCServiceClient(conn).RetrieveBlocks(ctx, &dalc.RetrieveBlocksRequest{DataLayerHeight: 1})
+		reqErr <- err
+	}()
+	<-retriever.called
+
+	// the in-flight request never completes, so the server is stopped
+	// forcefully after the shutdown timeout
+	start := time.Now()
+	require.NoError(t, srv.Stop(ctx))
+	assert.GreaterOrEqual(t, time.Since(start), time.Millisecond*100)
+	assert.Error(t, <-reqErr)
+}
+
+func TestServerStopInterruptedSubmissions(t *testing.T) {
+	ctx := context.Background()
+	app := &blockingTxService{called: make(chan struct{})}
+	appConn := testAppConn(t, app)
+
+	cfg := config.DefaultBlockSubmitterConfig()
+	bs, err := newBlockSubmitter(cfg, appConn, generateKeyring(t, cfg.KeyringAccName))
+	require.NoError(t, err)
+	// the account is known, so the submission goes straight to broadcasting
+	bs.signers.accounts[0].synced = true
+
+	srv := testServer(t, &blockingRetriever{}, time.Millisecond*100)
+	srv.lc.blockSubmitter = bs
+	require.NoError(t, srv.Start(ctx))
+
+	conn, err := grpc.Dial(srv.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	reqErr := make(chan error, 1)
+	go func() {
+		_, err := dalc.NewDALCServiceClient(conn).SubmitBlock(ctx, &dalc.SubmitBlockRequest{
+			Block: generateOptmintBlock(7, srv.lc.namespace),
+		})
+		reqErr <- err
+	}()
+	<-app.called
+
+	// the broadcast never completes, so the submission is cancelled after the
+	// shutdown timeout and reported
+	err = srv.Stop(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "[7]")
+	assert.Error(t, <-reqErr)
+	assert.Zero(t, srv.lc.pendingCount())
+}
+
+// testAppConn serves the tx service of celestia-app and returns a connection
+// to it
+func testAppConn(t *testing.T, txService tx.ServiceServer) *grpc.ClientConn {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	appSrv := grpc.NewServer()
+	tx.RegisterServiceServer(appSrv, txService)
+	go appSrv.Serve(lis) //nolint:errcheck
+	t.Cleanup(appSrv.Stop)
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// blockingTxService blocks every broadcast until it is cancelled
+type blockingTxService struct {
+	tx.UnimplementedServiceServer
+	called chan struct{}
+}
+
+func (bts *blockingTxService) BroadcastTx(ctx context.Context, req *tx.BroadcastTxRequest) (*tx.BroadcastTxResponse, error) {
+	close(bts.called)
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestServerStartListenError(t *testing.T) {
+	ctx := context.Background()
+	first := testServer(t, &blockingRetriever{}, time.Second)
+	require.NoError(t, first.Start(ctx))
+	t.Cleanup(func() { first.Stop(ctx) }) //nolint:errcheck
+
+	second := newGRPCServer(grpc.NewServer(), first.lc, first.Addr().String(), time.Second)
+	assert.Error(t, second.Start(ctx))
+}
+
+func testServer(t *testing.T, retriever dataRetriever, shutdownTimeout time.Duration) *Server {
+	t.Helper()
+	lc := &DataAvailabilityLightClient{
+		namespace: []byte{1, 2, 3, 4, 5, 6, 7, 8},
+		retriever: retriever,
+		pending:   make(map[uint64]int),
+	}
+	srv := grpc.NewServer()
+	dalc.RegisterDALCServiceServer(srv, lc)
+	return newGRPCServer(srv, lc, "127.0.0.1:0", shutdownTimeout)
+}
+
+// blockingRetriever blocks every request until it is cancelled
+type blockingRetriever struct {
+	called chan struct{}
+}
+
+func (br *blockingRetriever) SharesAvailable(ctx context.Context, height uint64) error {
+	return br.block(ctx)
+}
+
+func (br *blockingRetriever) GetSharesByNamespace(ctx context.Context, height uint64, nID namespace.ID) ([][]byte, error) {
+	return nil, br.block(ctx)
+}
+
+func (br *blockingRetriever) Head(ctx context.Context) (head, error) {
+	return head{}, br.block(ctx)
+}
+
+func (br *blockingRetriever) block(ctx context.Context) error {
+	if br.called != nil {
+		close(br.called)
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
@@ -0,0 +1,165 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/celestiaorg/dalc/config"
+)
+
+// dalcServiceName is the name under which the readiness of the DALCService is
+// reported, next to the overall readiness reported under the empty name
+const dalcServiceName = "dalc.DALCService"
+
+// healthCheck returns an error if a dependency of the dalc is not ready
+type healthCheck struct {
+	name  string
+	check func(ctx context.Context) error
+}
+
+// healthChecker periodically runs health checks and reports their result
+// through the grpc health service
+type healthChecker struct {
+	srv      *health.Server
+	checks   []healthCheck
+	interval time.Duration
+
+	cancel context.CancelFunc
+	done   chan struct{}
+	once   sync.Once
+}
+
+func newHealthChecker(interval time.Duration, checks ...healthCheck) *healthChecker {
+	if interval <= 0 {
+		interval = config.DefaultHealthConfig().CheckInterval
+	}
+	hc := &healthChecker{
+		srv:      health.NewServer(),
+		checks:   checks,
+		interval: interval,
+	}
+	// not ready until the first checks pass
+	hc.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+	return hc
+}
+
+// register registers the health service on the grpc server
+func (hc *healthChecker) register(srv *grpc.Server) {
+	healthpb.RegisterHealthServer(srv, hc.srv)
+}
+
+// start runs the checks in the background until stop is called
+func (hc *healthChecker) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	hc.cancel = cancel
+	hc.done = make(chan struct{})
+
+	go func() {
+		defer close(hc.done)
+		ticker := time.NewTicker(hc.interval)
+		defer ticker.Stop()
+		for {
+			hc.runChecks(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// stop stops running the checks and reports every service as not serving
+func (hc *healthChecker) stop() {
+	hc.once.Do(func() {
+		if hc.cancel != nil {
+			hc.cancel()
+			<-hc.done
+		}
+		hc.srv.Shutdown()
+	})
+}
+
+// runChecks runs every check and reports the dalc as serving if all of them
+// pass
+func (hc *healthChecker) runChecks(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, hc.interval)
+	defer cancel()
+
+	err := hc.check(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Warnw("dalc is not ready", "err", err)
+		}
+		hc.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+		return
+	}
+	hc.setStatus(healthpb.HealthCheckResponse_SERVING)
+}
+
+// check returns the error of the first check that fails
+func (hc *healthChecker) check(ctx context.Context) error {
+	for _, c := range hc.checks {
+		err := c.check(ctx)
+		if err != nil {
+			return fmt.Errorf("%s: %w", c.name, err)
+		}
+	}
+	return nil
+}
+
+func (hc *healthChecker) setStatus(status healthpb.HealthCheckResponse_ServingStatus) {
+	hc.srv.SetServingStatus("", status)
+	hc.srv.SetServingStatus(dalcServiceName, status)
+}
+
+// headSyncedCheck fails if the latest header known to the node is older than
+// maxAge, which means the node is still syncing or lost its peers
+func headSyncedCheck(retriever dataRetriever, maxAge time.Duration) healthCheck {
+	return healthCheck{
+		name: "celestia headers",
+		check: func(ctx context.Context) error {
+			h, err := retriever.Head(ctx)
+			if err != nil {
+				return err
+			}
+			if age := time.Since(h.Time); age > maxAge {
+				return fmt.Errorf("head at height %d is %s old", h.Height, age.Round(time.Second))
+			}
+			return nil
+		},
+	}
+}
+
+// appConnCheck fails if the connection to the celestia-app node is broken
+func appConnCheck(conn *grpc.ClientConn) healthCheck {
+	return healthCheck{
+		name: "celestia-app connection",
+		check: func(ctx context.Context) error {
+			switch state := conn.GetState(); state {
+			case connectivity.TransientFailure, connectivity.Shutdown:
+				return fmt.Errorf("connection is %s", state)
+			default:
+				return nil
+			}
+		},
+	}
+}
+
+// fundedCheck fails if the last known balances of the signer accounts can not
+// pay for a submission
+func fundedCheck(bm *balanceMonitor) healthCheck {
+	return healthCheck{
+		name: "signer account",
+		check: func(ctx context.Context) error {
+			return bm.anyCanPay()
+		},
+	}
+}
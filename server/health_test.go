@@ -0,0 +1,120 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-node/libs/keystore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+
+	"github.com/celestiaorg/dalc/auth"
+	"github.com/celestiaorg/dalc/config"
+)
+
+func TestHealthChecker(t *testing.T) {
+	ctx := context.Background()
+	var failing atomic.Value
+	failing.Store(false)
+	hc := newHealthChecker(time.Millisecond*10, healthCheck{
+		name: "dependency",
+		check: func(ctx context.Context) error {
+			if failing.Load().(bool) {
+				return errors.New("down")
+			}
+			return nil
+		},
+	})
+
+	srv := grpc.NewServer()
+	hc.register(srv)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go srv.Serve(lis) //nolint:errcheck
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	client := healthpb.NewHealthClient(conn)
+
+	status := func(service string) healthpb.HealthCheckResponse_ServingStatus {
+		resp, err := client.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+		require.NoError(t, err)
+		return resp.Status
+	}
+
+	// not ready before the checks ran
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status(""))
+
+	hc.start()
+	assert.Eventually(t, func() bool {
+		return status("") == healthpb.HealthCheckResponse_SERVING &&
+			status(dalcServiceName) == healthpb.HealthCheckResponse_SERVING
+	}, time.Second, time.Millisecond*10)
+
+	failing.Store(true)
+	assert.Eventually(t, func() bool {
+		return status(dalcServiceName) == healthpb.HealthCheckResponse_NOT_SERVING
+	}, time.Second, time.Millisecond*10)
+
+	failing.Store(false)
+	hc.stop()
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status(""))
+}
+
+func TestHeadSyncedCheck(t *testing.T) {
+	ctx := context.Background()
+	retriever := &staticRetriever{}
+
+	check := headSyncedCheck(retriever, time.Minute)
+	assert.NoError(t, check.check(ctx))
+
+	check = headSyncedCheck(retriever, -time.Minute)
+	assert.Error(t, check.check(ctx))
+}
+
+func TestReflectionRequiresToken(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.DefaultServerConfig(t.TempDir())
+	cfg.ListenAddr = "127.0.0.1:0"
+	cfg.KeyringBackend = "memory"
+	cfg.AuthConfig.Enabled = true
+	cfg.HealthConfig.Reflection = true
+	ks := keystore.NewMapKeystore()
+	srv, err := newServer(cfg, &staticRetriever{}, ks)
+	require.NoError(t, err)
+	require.NoError(t, srv.Start(ctx))
+	t.Cleanup(func() { srv.Stop(ctx) }) //nolint:errcheck
+
+	listServices := func(opts ...grpc.DialOption) error {
+		conn, err := grpc.Dial(srv.Addr().String(), append(opts, grpc.WithInsecure())...)
+		require.NoError(t, err)
+		defer conn.Close()
+		stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+		require.NoError(t, err)
+		err = stream.Send(&reflectionpb.ServerReflectionRequest{
+			MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+		})
+		require.NoError(t, err)
+		_, err = stream.Recv()
+		return err
+	}
+
+	assert.Equal(t, codes.Unauthenticated, status.Code(listServices()))
+
+	secret, err := auth.LoadOrGenerateSecret(ks)
+	require.NoError(t, err)
+	token, err := auth.NewToken(secret, []auth.Permission{auth.PermAdmin}, time.Minute)
+	require.NoError(t, err)
+	assert.NoError(t, listServices(grpc.WithPerRPCCredentials(auth.TokenCredentials{Token: token, AllowInsecure: true})))
+}
@@ -0,0 +1,225 @@
+package server
+
+import (
+	"encoding/binary"
+	"math/big"
+	"net/http"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/tendermint/tendermint/pkg/consts"
+)
+
+const metricsNamespace = "dalc"
+
+// metrics records the activity of the DataAvailabilityLightClient. A nil
+// *metrics records nothing, which is used when metrics are disabled.
+type metrics struct {
+	registry *prometheus.Registry
+
+	submissions      *prometheus.CounterVec
+	submitDuration   prometheus.Histogram
+	submitBytes      prometheus.Histogram
+	submitShares     prometheus.Histogram
+	submitGasUsed    prometheus.Histogram
+	feesSpent        *prometheus.CounterVec
+	retrieveDuration prometheus.Histogram
+	retrievedBlocks  prometheus.Histogram
+	decodeFailures   prometheus.Counter
+	availDuration    *prometheus.HistogramVec
+	availChecks      *prometheus.CounterVec
+	accountBalance   *prometheus.GaugeVec
+	accountSequence  *prometheus.GaugeVec
+}
+
+func newMetrics() *metrics {
+	m := &metrics{
+		registry: prometheus.NewRegistry(),
+		submissions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "submit_block_total",
+			Help: "Number of blocks submitted by result code: ok, error when no transaction was " +
+				"broadcasted, or the code of the rejected transaction.",
+		}, []string{"code"}),
+		submitDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "submit_block_duration_seconds",
+			Help:      "Time taken to submit a block.",
+			Buckets:   []float64{0.1, 0.5, 1, 2.5, 5, 10, 20, 40, 80, 160},
+		}),
+		submitBytes: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "submit_block_size_bytes",
+			Help:      "Size of the submitted blocks.",
+			Buckets:   prometheus.ExponentialBuckets(256, 4, 8),
+		}),
+		submitShares: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "submit_block_shares",
+			Help:      "Number of shares used by the submitted blocks.",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 12),
+		}),
+		submitGasUsed: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "submit_block_gas_used",
+			Help:      "Gas used by the transactions submitting blocks.",
+			Buckets:   prometheus.ExponentialBuckets(10000, 2, 10),
+		}),
+		feesSpent: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "submit_block_fees_total",
+			Help:      "Fees paid for the transactions submitting blocks.",
+		}, []string{"denom"}),
+		retrieveDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "retrieve_blocks_duration_seconds",
+			Help:      "Time taken to retrieve the blocks of a height.",
+			Buckets:   prometheus.DefBuckets,
+		}),
+		retrievedBlocks: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "retrieve_blocks_per_height",
+			Help:      "Number of blocks retrieved per height.",
+			Buckets:   []float64{0, 1, 2, 4, 8, 16},
+		}),
+		decodeFailures: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "retrieve_blocks_decode_failures_total",
+			Help:      "Number of retrievals that failed to decode the blocks in the namespace.",
+		}),
+		availDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "check_block_availability_duration_seconds",
+			Help:      "Time taken to check the availability of a height.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"available"}),
+		availChecks: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "check_block_availability_total",
+			Help:      "Number of availability checks by result.",
+		}, []string{"available"}),
+		accountBalance: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "signer_balance",
+			Help:      "Balance of the accounts signing the transactions.",
+		}, []string{"account", "denom"}),
+		accountSequence: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "signer_sequence",
+			Help:      "Sequence of the accounts signing the transactions.",
+		}, []string{"account"}),
+	}
+
+	m.registry.MustRegister(
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+		m.submissions,
+		m.submitDuration,
+		m.submitBytes,
+		m.submitShares,
+		m.submitGasUsed,
+		m.feesSpent,
+		m.retrieveDuration,
+		m.retrievedBlocks,
+		m.decodeFailures,
+		m.availDuration,
+		m.availChecks,
+		m.accountBalance,
+		m.accountSequence,
+	)
+	return m
+}
+
+// serveMetrics makes the server serve the metrics of the registry over http at
+// /metrics on addr
+func (s *Server) serveMetrics(addr string, registry *prometheus.Registry) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	s.serveHTTP("metrics", addr, mux, nil)
+}
+
+// submission describes the outcome of a call to SubmitBlock
+type submission struct {
+	start  time.Time
+	size   int
+	shares int
+	// code is the code of the broadcasted transaction, or -1 if none was
+	// broadcasted
+	code    int64
+	gasUsed int64
+	// fee is the fee paid for the transaction, if it was included in a block
+	fee sdk.Coin
+}
+
+func (m *metrics) observeSubmission(s submission) {
+	if m == nil {
+		return
+	}
+	m.submitDuration.Observe(time.Since(s.start).Seconds())
+	m.submitBytes.Observe(float64(s.size))
+	m.submitShares.Observe(float64(s.shares))
+
+	switch s.code {
+	case -1:
+		m.submissions.WithLabelValues("error").Inc()
+		return
+	case 0:
+		m.submissions.WithLabelValues("ok").Inc()
+	default:
+		m.submissions.WithLabelValues(strconv.FormatInt(s.code, 10)).Inc()
+	}
+	m.submitGasUsed.Observe(float64(s.gasUsed))
+	if s.fee.Denom != "" {
+		m.feesSpent.WithLabelValues(s.fee.Denom).Add(coinAmount(s.fee))
+	}
+}
+
+func (m *metrics) observeRetrieval(start time.Time, blocks int, decodeErr error) {
+	if m == nil {
+		return
+	}
+	m.retrieveDuration.Observe(time.Since(start).Seconds())
+	if decodeErr != nil {
+		m.decodeFailures.Inc()
+		return
+	}
+	m.retrievedBlocks.Observe(float64(blocks))
+}
+
+func (m *metrics) observeAvailability(start time.Time, available bool) {
+	if m == nil {
+		return
+	}
+	label := strconv.FormatBool(available)
+	m.availDuration.WithLabelValues(label).Observe(time.Since(start).Seconds())
+	m.availChecks.WithLabelValues(label).Inc()
+}
+
+func (m *metrics) observeAccount(state accountState) {
+	if m == nil {
+		return
+	}
+	m.accountBalance.WithLabelValues(state.name, state.balance.Denom).Set(coinAmount(state.balance))
+	m.accountSequence.WithLabelValues(state.name).Set(float64(state.sequence))
+}
+
+// sharesUsed returns the number of shares used by a message of the provided
+// size, which is prefixed with its length and split into message shares
+func sharesUsed(size int) int {
+	var prefix [binary.MaxVarintLen64]byte
+	total := binary.PutUvarint(prefix[:], uint64(size)) + size
+	return (total + consts.MsgShareSize - 1) / consts.MsgShareSize
+}
+
+// coinAmount returns the amount of a coin as a float, which may lose precision
+// for very large amounts
+func coinAmount(coin sdk.Coin) float64 {
+	if coin.Amount.IsNil() {
+		return 0
+	}
+	amount, _ := new(big.Float).SetInt(coin.Amount.BigInt()).Float64()
+	return amount
+}
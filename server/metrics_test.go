@@ -0,0 +1,89 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/nmt/namespace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coretypes "github.com/tendermint/tendermint/types"
+
+	"github.com/celestiaorg/dalc/proto/dalc"
+)
+
+func TestSharesUsed(t *testing.T) {
+	ns := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	for _, size := range []int{0, 1, 200, 245, 246, 1000, 100000} {
+		msgs := coretypes.Messages{MessagesList: []coretypes.Message{{NamespaceID: ns, Data: make([]byte, size)}}}
+		assert.Equal(t, len(msgs.SplitIntoShares()), sharesUsed(size), size)
+	}
+}
+
+func TestMetricsEndpoint(t *testing.T) {
+	ctx := context.Background()
+	ns := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	rawBlock, err := generateOptmintBlock(1, ns).Marshal()
+	require.NoError(t, err)
+	msgs := coretypes.Messages{MessagesList: []coretypes.Message{{NamespaceID: ns, Data: rawBlock}}}
+	retriever := &staticRetriever{shares: msgs.SplitIntoShares().RawShares()}
+
+	srv := testServer(t, retriever, time.Second)
+	srv.lc.metrics = newMetrics()
+	addr := freeAddr(t)
+	srv.serveMetrics(addr, srv.lc.metrics.registry)
+	require.NoError(t, srv.Start(ctx))
+	t.Cleanup(func() { srv.Stop(ctx) }) //nolint:errcheck
+
+	_, err = srv.lc.CheckBlockAvailability(ctx, &dalc.CheckBlockAvailabilityRequest{DataLayerHeight: 1})
+	require.NoError(t, err)
+	resp, err := srv.lc.RetrieveBlocks(ctx, &dalc.RetrieveBlocksRequest{DataLayerHeight: 1})
+	require.NoError(t, err)
+	require.Len(t, resp.Blocks, 1)
+
+	// shares that do not contain optimint blocks fail to decode
+	msgs.MessagesList[0].Data = []byte{0xff, 0xff, 0xff}
+	retriever.shares = msgs.SplitIntoShares().RawShares()
+	_, err = srv.lc.RetrieveBlocks(ctx, &dalc.RetrieveBlocksRequest{DataLayerHeight: 2})
+	require.Error(t, err)
+
+	httpResp, err := http.Get("http://" + addr + "/metrics")
+	require.NoError(t, err)
+	defer httpResp.Body.Close()
+	body, err := io.ReadAll(httpResp.Body)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(body), `dalc_check_block_availability_total{available="true"} 1`)
+	assert.Contains(t, string(body), "dalc_retrieve_blocks_per_height_sum 1")
+	assert.Contains(t, string(body), "dalc_retrieve_blocks_decode_failures_total 1")
+}
+
+// staticRetriever returns the same shares for every height
+type staticRetriever struct {
+	shares [][]byte
+}
+
+func (sr *staticRetriever) SharesAvailable(ctx context.Context, height uint64) error {
+	return nil
+}
+
+func (sr *staticRetriever) GetSharesByNamespace(ctx context.Context, height uint64, nID namespace.ID) ([][]byte, error) {
+	return sr.shares, nil
+}
+
+func (sr *staticRetriever) Head(ctx context.Context) (head, error) {
+	return head{Height: 1, Time: time.Now()}, nil
+}
+
+// freeAddr returns a local address that is not in use
+func freeAddr(t *testing.T) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer lis.Close()
+	return lis.Addr().String()
+}
@@ -4,14 +4,36 @@ import (
 	"github.com/celestiaorg/celestia-node/libs/utils"
 	"github.com/celestiaorg/celestia-node/node"
 	"github.com/celestiaorg/celestia-node/node/fxutil"
+	"github.com/celestiaorg/dalc/cli"
 	"github.com/celestiaorg/dalc/config"
 	logging "github.com/ipfs/go-log/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.opentelemetry.io/otel"
 	"go.uber.org/fx"
 )
 
-var log = logging.Logger("dalc/server")
+var (
+	log    = logging.Logger("dalc/server")
+	tracer = otel.Tracer("dalc/server")
+)
+
+// storeFlag is the flag used by celestia-node to set the path of the node store
+const storeFlag = "node.store"
 
-type NodePlugin struct{}
+// NodePlugin installs the dalc into a celestia-node
+type NodePlugin struct {
+	flags *pflag.FlagSet
+}
+
+// Flags returns the flags overriding the dalc config, which are meant to be
+// added to the celestia root command
+func (onp *NodePlugin) Flags() *pflag.FlagSet {
+	if onp.flags == nil {
+		onp.flags = config.Flags()
+	}
+	return onp.flags
+}
 
 func (onp *NodePlugin) Name() string {
 	return "Optimint GRPC Adapter"
@@ -29,11 +51,34 @@ func (onp *NodePlugin) Initialize(path string) error {
 	} else {
 		log.Infow("Config already exists", "path", cfgPath)
 	}
-	return nil
+	// validate the file as overridden by the DALC_* environment variables
+	cfg, _, err := config.LoadEffective(path, nil)
+	if err != nil {
+		return err
+	}
+	return cfg.Validate()
+}
+
+// Command returns the commands managing the dalc, which are meant to be added
+// to the celestia root command
+func (onp *NodePlugin) Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dalc [subcommand]",
+		Short: "Manage the Optimint GRPC Adapter",
+		Args:  cobra.NoArgs,
+	}
+	cmd.PersistentFlags().String(storeFlag, "~/.celestia-light", "path to the node store the dalc is installed in")
+	cmd.AddCommand(
+		cli.AuthCmd(storeFlag),
+		cli.KeysCmd(storeFlag),
+		cli.ClientCmd(storeFlag),
+		cli.ConfigCmd(storeFlag),
+	)
+	return cmd
 }
 
 func (onp *NodePlugin) Components(cfg *node.Config, store node.Store) fxutil.Option {
-	configLoader, err := LoadConfig(store)
+	configLoader, err := LoadConfig(store, onp.flags)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -45,9 +90,17 @@ func (onp *NodePlugin) Components(cfg *node.Config, store node.Store) fxutil.Opt
 			),
 		),
 	)
+	// reload the config with the same overrides it was loaded with
+	watchConfig := func(srv *Server) {
+		srv.WatchConfig(config.ConfigPath(store.Path()), func() (config.ServerConfig, error) {
+			cfg, _, err := config.LoadEffective(store.Path(), onp.flags)
+			return cfg, err
+		})
+	}
 	return fxutil.Options(
 		fxutil.Provide(configLoader),
 		fxutil.Provide(DALC),
+		fxutil.Invoke(watchConfig),
 		annotated,
 	)
 }
@@ -0,0 +1,170 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	logging "github.com/ipfs/go-log/v2"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/celestiaorg/dalc/config"
+)
+
+// configPollInterval is the interval at which the watched config file is
+// checked for changes
+const configPollInterval = 5 * time.Second
+
+// configWatcher reloads the config of the server when its file changes
+type configWatcher struct {
+	file string
+	load func() (config.ServerConfig, error)
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// WatchConfig makes the server reload its config with load whenever file is
+// modified or the process receives SIGHUP, from the time it starts until it
+// stops. See Reload for the fields that are applied.
+func (s *Server) WatchConfig(file string, load func() (config.ServerConfig, error)) {
+	s.watcher = &configWatcher{file: file, load: load}
+}
+
+// Reload applies the fields of cfg that can change while the dalc is running:
+// the fee amount, the gas limit, the log level and the low balance threshold.
+// Nothing is applied if cfg is invalid or changes other fields, which are only
+// applied when the dalc starts.
+func (s *Server) Reload(cfg config.ServerConfig) error {
+	err := cfg.Validate()
+	if err != nil {
+		return err
+	}
+
+	s.reloadMtx.Lock()
+	defer s.reloadMtx.Unlock()
+	if keys := s.cfg.RestartRequired(cfg); len(keys) > 0 {
+		return fmt.Errorf("dalc: changing %s requires a restart", strings.Join(keys, ", "))
+	}
+
+	err = setLogLevel(cfg.LogLevel)
+	if err != nil {
+		return err
+	}
+	bs := &s.lc.blockSubmitter
+	bs.setFees(cfg.FeeAmount, cfg.GasLimit)
+	s.lc.balance.setLimits(bs.fee(), cfg.BalanceConfig.LowThreshold)
+	s.cfg = cfg
+	log.Infow("reloaded dalc config",
+		"fee_amount", cfg.FeeAmount,
+		"gas_limit", cfg.GasLimit,
+		"log_level", cfg.LogLevel,
+		"low_threshold", cfg.BalanceConfig.LowThreshold,
+	)
+	return nil
+}
+
+var (
+	nodeLogLevelOnce sync.Once
+	// nodeLogLevel is the level of the dalc loggers before the config first
+	// set it, which is the level set for the celestia-node
+	nodeLogLevel string
+)
+
+// setLogLevel sets the level of the dalc loggers. An empty level resets them to
+// the level set for the celestia-node.
+func setLogLevel(level string) error {
+	nodeLogLevelOnce.Do(func() {
+		nodeLogLevel = loggerLevel(log)
+	})
+	if level == "" {
+		level = nodeLogLevel
+	}
+	return logging.SetLogLevelRegex("^dalc/", level)
+}
+
+// loggerLevel returns the lowest level logger writes
+func loggerLevel(logger *logging.ZapEventLogger) string {
+	core := logger.Desugar().Core()
+	lvl := zapcore.DebugLevel
+	for lvl < zapcore.FatalLevel && !core.Enabled(lvl) {
+		lvl++
+	}
+	return lvl.String()
+}
+
+// startWatching starts reloading the config in the background if WatchConfig
+// was called
+func (s *Server) startWatching() {
+	w := s.watcher
+	if w == nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	w.done = make(chan struct{})
+	modTime := fileModTime(w.file)
+	go func() {
+		defer close(w.done)
+		s.watchConfig(ctx, configPollInterval, modTime)
+	}()
+}
+
+// stopWatching stops reloading the config
+func (s *Server) stopWatching() {
+	w := s.watcher
+	if w == nil || w.cancel == nil {
+		return
+	}
+	w.cancel()
+	<-w.done
+}
+
+// watchConfig reloads the config whenever the modification time of the file
+// differs from the last one seen or the process receives SIGHUP, until ctx is
+// done
+func (s *Server) watchConfig(ctx context.Context, interval time.Duration, modTime time.Time) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			log.Infow("received SIGHUP, reloading dalc config", "path", s.watcher.file)
+		case <-ticker.C:
+			latest := fileModTime(s.watcher.file)
+			if latest.Equal(modTime) {
+				continue
+			}
+			modTime = latest
+		}
+
+		cfg, err := s.watcher.load()
+		if err == nil {
+			err = s.Reload(cfg)
+		}
+		if err != nil {
+			log.Errorw("reloading dalc config, keeping the running one", "path", s.watcher.file, "err", err)
+		}
+	}
+}
+
+// fileModTime returns the modification time of the file, or the zero time if
+// it can not be read, such as while it is being replaced
+func fileModTime(file string) time.Time {
+	info, err := os.Stat(file)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
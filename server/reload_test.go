@@ -0,0 +1,98 @@
+package server
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/dalc/config"
+)
+
+func testReloadServer(t *testing.T, dir string) *Server {
+	t.Helper()
+	srv := testServer(t, &staticRetriever{}, time.Second)
+	srv.cfg = config.DefaultServerConfig(dir)
+	srv.lc.blockSubmitter, _ = testBlockSubmitter(t, srv.cfg.BlockSubmitterConfig)
+	srv.lc.balance = testBalanceMonitor(1100)
+	return srv
+}
+
+func TestReload(t *testing.T) {
+	srv := testReloadServer(t, t.TempDir())
+	require.NoError(t, srv.lc.balance.check(context.Background()))
+	assert.False(t, srv.lc.balance.Low())
+
+	cfg := srv.cfg
+	cfg.FeeAmount = 2000
+	cfg.GasLimit = 42
+	cfg.BalanceConfig.LowThreshold = 5000
+	require.NoError(t, srv.Reload(cfg))
+	assert.Equal(t, submitFees{amount: 2000, gasLimit: 42}, srv.lc.blockSubmitter.fees())
+	assert.Equal(t, sdk.NewInt64Coin(cfg.Denom, 2000), srv.lc.blockSubmitter.fee())
+	assert.True(t, srv.lc.balance.Low())
+	assert.ErrorIs(t, srv.lc.balance.canPay("poor"), ErrInsufficientFunds)
+	assert.NoError(t, srv.lc.balance.canPay("rich"))
+
+	// changes to fields applied on start are rejected as a whole
+	next := cfg
+	next.FeeAmount = 1
+	next.ListenAddr = "127.0.0.1:1"
+	assert.ErrorContains(t, srv.Reload(next), "changing base.laddr requires a restart")
+	assert.Equal(t, uint64(2000), srv.lc.blockSubmitter.fees().amount)
+
+	next = cfg
+	next.GasLimit = 0
+	assert.Error(t, srv.Reload(next))
+	assert.Equal(t, uint64(42), srv.lc.blockSubmitter.fees().gasLimit)
+}
+
+func TestReloadLogLevel(t *testing.T) {
+	srv := testReloadServer(t, t.TempDir())
+	nodeLevel := loggerLevel(log)
+	require.NotEqual(t, "debug", nodeLevel)
+
+	cfg := srv.cfg
+	cfg.LogLevel = "debug"
+	require.NoError(t, srv.Reload(cfg))
+	assert.Equal(t, "debug", loggerLevel(log))
+
+	// removing the level resets the loggers to the one of the node
+	cfg.LogLevel = ""
+	require.NoError(t, srv.Reload(cfg))
+	assert.Equal(t, nodeLevel, loggerLevel(log))
+}
+
+func TestWatchConfig(t *testing.T) {
+	dir := t.TempDir()
+	srv := testReloadServer(t, dir)
+	require.NoError(t, srv.cfg.Save(dir))
+	srv.WatchConfig(config.ConfigPath(dir), func() (config.ServerConfig, error) {
+		return config.Load(dir)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	modTime := fileModTime(config.ConfigPath(dir))
+	go func() {
+		defer close(done)
+		srv.watchConfig(ctx, time.Millisecond*10, modTime)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	cfg := srv.cfg
+	cfg.FeeAmount = 7
+	require.NoError(t, cfg.Save(dir))
+	// make sure the modification time differs from the one of the saved file
+	require.NoError(t, os.Chtimes(config.ConfigPath(dir), time.Now(), modTime.Add(time.Second)))
+	assert.Eventually(t, func() bool {
+		return srv.lc.blockSubmitter.fees().amount == 7
+	}, time.Second, time.Millisecond*10)
+}
@@ -0,0 +1,157 @@
+package server
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/celestiaorg/nmt/namespace"
+	"github.com/tendermint/tendermint/pkg/consts"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// The endpoints of the RPC API served by celestia-node from v0.3.0 on, in
+// service/rpc. The celestia-node the dalc is embedded in as a plugin serves no
+// HTTP API, so the remote node must run v0.3.0 or later:
+//
+//	GET /namespaced_shares/{nid}/height/{height}
+//		{"shares": [<base64 share>...], "height": <height>}
+//	GET /data_available/{height}
+//		{"available": <bool>, "probability_of_availability": "<float>"}
+//	GET /head
+//		{"header": {"height": <height>, "time": "<RFC 3339>", ...}, "commit": ..., "dah": ...}
+//
+// Errors are answered with a non 200 status and the message as a JSON string.
+const (
+	namespacedSharesEndpoint = "/namespaced_shares"
+	dataAvailableEndpoint    = "/data_available"
+	headEndpoint             = "/head"
+)
+
+// remoteRetriever reads data from the RPC API of a remote celestia-node
+type remoteRetriever struct {
+	addr   string
+	client *http.Client
+}
+
+func newRemoteRetriever(addr string, timeout time.Duration) *remoteRetriever {
+	if !strings.Contains(addr, "://") {
+		addr = "http://" + addr
+	}
+	return &remoteRetriever{
+		addr:   strings.TrimSuffix(addr, "/"),
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// namespacedSharesResponse is the response of the namespaced shares endpoint,
+// rpc.NamespacedSharesResponse in celestia-node
+type namespacedSharesResponse struct {
+	Shares [][]byte `json:"shares"`
+	Height uint64   `json:"height"`
+}
+
+// headResponse holds the fields of the header.ExtendedHeader returned by the
+// head endpoint
+type headResponse struct {
+	Header struct {
+		Height int64     `json:"height"`
+		Time   time.Time `json:"time"`
+	} `json:"header"`
+}
+
+// availabilityResponse holds the fields of the response of the data
+// availability endpoint, rpc.AvailabilityResponse in celestia-node
+type availabilityResponse struct {
+	Available bool `json:"available"`
+}
+
+func (rr *remoteRetriever) SharesAvailable(ctx context.Context, height uint64) (err error) {
+	ctx, span := rr.startSpan(ctx, "SharesAvailable", height)
+	defer func() { endSpan(span, err) }()
+
+	var resp availabilityResponse
+	err = rr.get(ctx, fmt.Sprintf("%s/%d", dataAvailableEndpoint, height), &resp)
+	if err != nil {
+		return err
+	}
+	if !resp.Available {
+		return fmt.Errorf("data at height %d is not available", height)
+	}
+	return nil
+}
+
+func (rr *remoteRetriever) GetSharesByNamespace(ctx context.Context, height uint64, nID namespace.ID) (_ [][]byte, err error) {
+	ctx, span := rr.startSpan(ctx, "GetSharesByNamespace", height)
+	defer func() { endSpan(span, err) }()
+
+	// the remote node serves the shares of its latest header at height zero
+	if height == 0 {
+		return nil, fmt.Errorf("height must be positive")
+	}
+
+	var resp namespacedSharesResponse
+	err = rr.get(ctx, fmt.Sprintf("%s/%s/height/%d", namespacedSharesEndpoint, hex.EncodeToString(nID), height), &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	// the remote node returns whole shares, which are prefixed by their
+	// namespace
+	for _, rawShare := range resp.Shares {
+		if len(rawShare) != consts.ShareSize {
+			return nil, fmt.Errorf("remote node returned a share of invalid size %d", len(rawShare))
+		}
+	}
+	return resp.Shares, nil
+}
+
+func (rr *remoteRetriever) Head(ctx context.Context) (head, error) {
+	var resp headResponse
+	err := rr.get(ctx, headEndpoint, &resp)
+	if err != nil {
+		return head{}, err
+	}
+	return head{Height: uint64(resp.Header.Height), Time: resp.Header.Time}, nil
+}
+
+// startSpan starts a span covering a request to the remote node, which fetches
+// both the header and the data of the height
+func (rr *remoteRetriever) startSpan(ctx context.Context, name string, height uint64) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		attribute.Int64("height", int64(height)),
+		attribute.String("celestia_node", rr.addr),
+	))
+}
+
+func (rr *remoteRetriever) get(ctx context.Context, endpoint string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rr.addr+endpoint, nil)
+	if err != nil {
+		return err
+	}
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := rr.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		msg := strings.TrimSpace(string(body))
+		// the error message is written as a JSON string
+		_ = json.Unmarshal(body, &msg)
+		return fmt.Errorf("celestia-node %s: %s: %s", endpoint, resp.Status, msg)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
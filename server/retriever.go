@@ -0,0 +1,95 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/celestiaorg/celestia-node/service/header"
+	"github.com/celestiaorg/celestia-node/service/share"
+	"github.com/celestiaorg/nmt/namespace"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// dataRetriever reads data from celestia blocks
+type dataRetriever interface {
+	// SharesAvailable returns an error if the data of the celestia block at the
+	// provided height is not available
+	SharesAvailable(ctx context.Context, height uint64) error
+	// GetSharesByNamespace returns the shares of the provided namespace in the
+	// celestia block at the provided height, prefixed by their namespace as
+	// expected by coretypes.ParseMsgs
+	GetSharesByNamespace(ctx context.Context, height uint64, nID namespace.ID) ([][]byte, error)
+	// Head returns the latest celestia header known to the node
+	Head(ctx context.Context) (head, error)
+}
+
+// head describes the latest celestia header known to the node
+type head struct {
+	Height uint64
+	Time   time.Time
+}
+
+// localRetriever reads data using the services of the celestia-node the dalc
+// is embedded in
+type localRetriever struct {
+	hstore header.Store
+	ss     share.Service
+}
+
+func newLocalRetriever(ss share.Service, hstore header.Store) *localRetriever {
+	return &localRetriever{
+		hstore: hstore,
+		ss:     ss,
+	}
+}
+
+func (lr *localRetriever) SharesAvailable(ctx context.Context, height uint64) error {
+	extHeader, err := lr.getHeader(ctx, height)
+	if err != nil {
+		return err
+	}
+
+	ctx, span := tracer.Start(ctx, "SharesAvailable")
+	err = lr.ss.SharesAvailable(ctx, extHeader.DAH)
+	endSpan(span, err)
+	return err
+}
+
+func (lr *localRetriever) GetSharesByNamespace(ctx context.Context, height uint64, nID namespace.ID) ([][]byte, error) {
+	extHeader, err := lr.getHeader(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, span := tracer.Start(ctx, "GetSharesByNamespace")
+	shares, err := lr.ss.GetSharesByNamespace(ctx, extHeader.DAH, nID)
+	span.SetAttributes(attribute.Int("shares", len(shares)))
+	endSpan(span, err)
+	if err != nil {
+		return nil, err
+	}
+
+	rawShares := make([][]byte, len(shares))
+	for i, share := range shares {
+		// the leaves of the namespaced merkle tree prefix the share with its
+		// namespace once more
+		rawShares[i] = share.Data()
+	}
+	return rawShares, nil
+}
+
+func (lr *localRetriever) Head(ctx context.Context) (head, error) {
+	extHeader, err := lr.hstore.Head(ctx)
+	if err != nil {
+		return head{}, err
+	}
+	return head{Height: uint64(extHeader.Height), Time: extHeader.Time}, nil
+}
+
+func (lr *localRetriever) getHeader(ctx context.Context, height uint64) (*header.ExtendedHeader, error) {
+	ctx, span := tracer.Start(ctx, "GetHeader", trace.WithAttributes(attribute.Int64("height", int64(height))))
+	extHeader, err := lr.hstore.GetByHeight(ctx, height)
+	endSpan(span, err)
+	return extHeader, err
+}
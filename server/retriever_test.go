@@ -0,0 +1,58 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-node/ipld"
+	"github.com/celestiaorg/celestia-node/service/header"
+	"github.com/celestiaorg/celestia-node/service/share"
+	mdutils "github.com/ipfs/go-merkledag/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coretypes "github.com/tendermint/tendermint/types"
+
+	"github.com/celestiaorg/dalc/proto/dalc"
+)
+
+// TestLocalRetriever retrieves a block from a square holding its message as
+// celestia-app would include it
+func TestLocalRetriever(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	namespaceID := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	block := generateOptmintBlock(1, namespaceID)
+	message, err := block.Marshal()
+	require.NoError(t, err)
+
+	msgs := coretypes.Messages{MessagesList: []coretypes.Message{{NamespaceID: namespaceID, Data: message}}}
+	shares := msgs.SplitIntoShares()
+	shares = append(shares, coretypes.TailPaddingShares(4-len(shares))...)
+	dag := mdutils.Mock()
+	eds, err := ipld.PutData(ctx, shares.RawShares(), dag)
+	require.NoError(t, err)
+	dah, err := header.DataAvailabilityHeaderFromExtendedData(eds)
+	require.NoError(t, err)
+
+	suite := header.NewTestSuite(t, 1)
+	extHeader := suite.GenExtendedHeader()
+	extHeader.DataHash = dah.Hash()
+	extHeader.DAH = &dah
+	extHeader.Commit = suite.Commit(&extHeader.RawHeader)
+	require.NoError(t, extHeader.ValidateBasic())
+
+	ss := share.NewService(dag, share.NewFullAvailability(dag))
+	lr := newLocalRetriever(ss, header.NewTestStore(context.Background(), t, extHeader))
+	// the store only serves its heights once it loaded its head
+	h, err := lr.Head(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), h.Height)
+
+	srv := testServer(t, lr, 0)
+	resp, err := srv.lc.RetrieveBlocks(ctx, &dalc.RetrieveBlocksRequest{DataLayerHeight: 1})
+	require.NoError(t, err)
+	require.Len(t, resp.Blocks, 1)
+	assert.Equal(t, *block, *resp.Blocks[0])
+	assert.NoError(t, lr.SharesAvailable(ctx, 1))
+}
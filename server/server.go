@@ -2,39 +2,84 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 
-	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	coretypes "github.com/tendermint/tendermint/types"
 
+	"github.com/celestiaorg/celestia-node/libs/keystore"
 	"github.com/celestiaorg/celestia-node/service/header"
 	"github.com/celestiaorg/celestia-node/service/share"
+	"github.com/celestiaorg/dalc/auth"
+	"github.com/celestiaorg/dalc/chaos"
 	"github.com/celestiaorg/dalc/config"
 	"github.com/celestiaorg/dalc/proto/dalc"
 	"github.com/celestiaorg/dalc/proto/optimint"
+	"github.com/celestiaorg/dalc/signer"
+	"github.com/celestiaorg/dalc/tracing"
 )
 
-// New creates a grpc server ready to listen for incoming messages from optimint
-func New(cfg config.ServerConfig, ss share.Service, hstore header.Store) (*grpc.Server, error) {
-	// connect to a celestia full node to submit txs/query todo: change when
-	// celestia-node does this for us
-	client, err := grpc.Dial(cfg.GRPCAddress, grpc.WithInsecure())
+// New creates a grpc server ready to listen for incoming messages from
+// optimint. The keystore holds the secret used to authorize requests.
+func New(cfg config.ServerConfig, ss share.Service, hstore header.Store, ks keystore.Keystore) (*Server, error) {
+	return newServer(cfg, newLocalRetriever(ss, hstore), ks)
+}
+
+// NewRemote creates a grpc server ready to listen for incoming messages from
+// optimint, which reads celestia data from the RPC API of a remote
+// celestia-node instead of an embedding one
+func NewRemote(cfg config.ServerConfig, ks keystore.Keystore) (*Server, error) {
+	return newServer(cfg, newRemoteRetriever(cfg.NodeRPCAddress, cfg.Timeout), ks)
+}
+
+func newServer(cfg config.ServerConfig, retriever dataRetriever, ks keystore.Keystore) (_ *Server, err error) {
+	err = setLogLevel(cfg.LogLevel)
 	if err != nil {
 		return nil, err
 	}
 
-	// open a keyring using the configured settings
-	ring, err := keyring.New(cfg.KeyringAccName, cfg.KeyringBackend, cfg.KeyringPath, strings.NewReader("."))
+	// connect to a celestia full node to submit txs/query todo: change when
+	// celestia-node does this for us
+	client, err := dialApp(cfg.GRPCAddress, cfg.AppConnConfig)
 	if err != nil {
 		return nil, err
 	}
+	// the connection is only used by the server that is returned
+	defer func() {
+		if err != nil {
+			client.Close()
+		}
+	}()
+
+	// sign with the remote signing daemon if configured, or open a keyring
+	// using the configured settings
+	var keys signer.Signer
+	if cfg.SignerConfig.RemoteAddress != "" {
+		keys = signer.NewRemote(cfg.SignerConfig.RemoteAddress, cfg.SignerConfig.RemoteToken, cfg.SignerConfig.RemoteTimeout)
+	} else {
+		keys, err = signer.OpenKeyring(cfg.KeyringAccName, cfg.KeyringConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	bs, err := newBlockSubmitter(cfg.BlockSubmitterConfig, client, ring)
+	bs, err := newBlockSubmitter(cfg.BlockSubmitterConfig, client, keys)
 	if err != nil {
 		return nil, err
 	}
@@ -47,55 +92,181 @@ func New(cfg config.ServerConfig, ss share.Service, hstore header.Store) (*grpc.
 	lc := &DataAvailabilityLightClient{
 		namespace:      namespace,
 		blockSubmitter: bs,
-		ss:             ss,
-		hstore:         hstore,
+		retriever:      retriever,
+		pending:        make(map[uint64]int),
+	}
+	if cfg.MetricsConfig.Enabled {
+		lc.metrics = newMetrics()
+	}
+	lc.balance = newBalanceMonitor(bs, cfg.BalanceConfig, lc.metrics)
+	lc.refuseUnfunded = cfg.BalanceConfig.RefuseUnfunded
+
+	var opts []grpc.ServerOption
+	// continue the traces started by optimint
+	interceptors := []grpc.UnaryServerInterceptor{otelgrpc.UnaryServerInterceptor()}
+	streamInterceptors := []grpc.StreamServerInterceptor{otelgrpc.StreamServerInterceptor()}
+	var gatewayTLS *tls.Config
+	if cfg.TLSConfig.Enabled() {
+		reloader, err := newCertReloader(cfg.TLSConfig)
+		if err != nil {
+			return nil, err
+		}
+		// grpc is served over http/2 only, while the gateway also serves
+		// http/1.1 clients
+		opts = append(opts, grpc.Creds(credentials.NewTLS(reloader.TLSConfig("h2"))))
+		gatewayTLS = reloader.TLSConfig("h2", "http/1.1")
+	} else {
+		log.Warnw("serving without tls, requests are not encrypted", "address", cfg.ListenAddr)
+	}
+
+	var secret []byte
+	if cfg.AuthConfig.Enabled {
+		secret, err = auth.LoadOrGenerateSecret(ks)
+		if err != nil {
+			return nil, err
+		}
+		interceptors = append(interceptors, auth.UnaryServerInterceptor(secret))
+		streamInterceptors = append(streamInterceptors, auth.StreamServerInterceptor(secret))
+	} else {
+		log.Warnw("authorization is disabled, anyone reaching the dalc can submit blocks", "address", cfg.ListenAddr)
 	}
 
-	srv := grpc.NewServer()
-	dalc.RegisterDALCServiceServer(srv, lc)
+	opts = append(opts, grpc.ChainUnaryInterceptor(interceptors...), grpc.ChainStreamInterceptor(streamInterceptors...))
+	srv := grpc.NewServer(opts...)
 
-	return srv, nil
+	hc := newHealthChecker(
+		cfg.HealthConfig.CheckInterval,
+		headSyncedCheck(retriever, cfg.HealthConfig.MaxHeadAge),
+		appConnCheck(client),
+		fundedCheck(lc.balance),
+	)
+	hc.register(srv)
+	if cfg.HealthConfig.Reflection {
+		reflection.Register(srv)
+	}
+
+	var svc dalc.DALCServiceServer = lc
+	if cfg.ChaosConfig.Enabled {
+		chaosLC, err := chaos.New(lc, cfg.ChaosConfig)
+		if err != nil {
+			return nil, err
+		}
+		log.Warnw("fault injection is enabled, the dalc will misbehave on purpose")
+		dalc.RegisterChaosServiceServer(srv, chaosLC)
+		svc = chaosLC
+	}
+	dalc.RegisterDALCServiceServer(srv, svc)
+
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.TracingConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	s := newGRPCServer(srv, lc, cfg.ListenAddr, cfg.ShutdownTimeout)
+	s.cfg = cfg
+	s.shutdownTracing = shutdownTracing
+	s.health = hc
+	if lc.metrics != nil {
+		s.serveMetrics(cfg.MetricsConfig.Address, lc.metrics.registry)
+	}
+	if cfg.GatewayConfig.Enabled {
+		s.serveHTTP("gateway", cfg.GatewayConfig.Address, newGateway(svc, secret), gatewayTLS)
+	}
+	return s, nil
 }
 
+// Version is the version of the dalc, which is set at build time
+var Version = "dev"
+
 type DataAvailabilityLightClient struct {
 	namespace      []byte
 	blockSubmitter blockSubmitter
-	hstore         header.Store
-	ss             share.Service
+	retriever      dataRetriever
+	metrics        *metrics
+	// balance tracks the balance of the signer account
+	balance *balanceMonitor
+	// refuseUnfunded rejects the submissions the signer can not pay for
+	refuseUnfunded bool
+
+	// pendingMtx guards pending, which counts the blocks currently being
+	// submitted by their optimint height, and interrupted, which holds the
+	// heights of the blocks that were being submitted when the server was
+	// stopped forcefully
+	pendingMtx  sync.Mutex
+	pending     map[uint64]int
+	interrupted []uint64
 }
 
 // SubmitBlock posts an optimint block to celestia
 func (d *DataAvailabilityLightClient) SubmitBlock(ctx context.Context, blockReq *dalc.SubmitBlockRequest) (*dalc.SubmitBlockResponse, error) {
+	height := blockReq.Block.GetHeader().GetHeight()
+	d.trackSubmission(height)
+	defer d.untrackSubmission(height)
+
+	sub := submission{start: time.Now(), code: -1}
+	if blockReq.Block != nil {
+		sub.size = blockReq.Block.Size()
+		sub.shares = sharesUsed(sub.size)
+	}
+	defer func() { d.metrics.observeSubmission(sub) }()
+
+	// only the accounts that can pay sign the block if unfunded submissions
+	// are refused
+	var canPay func(name string) error
+	if d.refuseUnfunded {
+		canPay = d.balance.canPay
+	}
+
 	// submit the block
-	broadcastResp, err := d.blockSubmitter.SubmitBlock(ctx, blockReq.Block)
+	broadcastResp, acc, err := d.blockSubmitter.SubmitBlock(ctx, blockReq.Block, canPay)
+	var signerAddress string
+	if acc != nil {
+		signerAddress, _ = acc.address()
+	}
+	if errors.Is(err, ErrInsufficientFunds) {
+		return &dalc.SubmitBlockResponse{
+			Result:        &dalc.DAResponse{Code: dalc.StatusCode_STATUS_CODE_ERROR, Message: err.Error()},
+			SignerAddress: signerAddress,
+		}, status.Error(codes.FailedPrecondition, err.Error())
+	}
 	if err != nil {
 		return &dalc.SubmitBlockResponse{
-			Result: &dalc.DAResponse{Code: dalc.StatusCode_STATUS_CODE_ERROR, Message: err.Error()},
+			Result:        &dalc.DAResponse{Code: dalc.StatusCode_STATUS_CODE_ERROR, Message: err.Error()},
+			SignerAddress: signerAddress,
 		}, err
 	}
 
 	// handle response
 	resp := broadcastResp.TxResponse
+	sub.code = int64(resp.Code)
+	sub.gasUsed = resp.GasUsed
+	// transactions included in a block pay their fee even if they failed
+	if resp.Height != 0 {
+		sub.fee = d.blockSubmitter.fee()
+		d.balance.spend(acc.name, sub.fee)
+	}
+
 	if resp.Code != 0 {
 		return &dalc.SubmitBlockResponse{
 			Result: &dalc.DAResponse{
 				Code:    dalc.StatusCode_STATUS_CODE_ERROR,
 				Message: fmt.Sprintf("failed to submit tx: code %d: %s", resp.Code, resp.RawLog),
 			},
+			SignerAddress: signerAddress,
 		}, err
 	}
 
-	return &dalc.SubmitBlockResponse{Result: &dalc.DAResponse{Code: dalc.StatusCode_STATUS_CODE_SUCCESS}}, nil
+	return &dalc.SubmitBlockResponse{
+		Result:        &dalc.DAResponse{Code: dalc.StatusCode_STATUS_CODE_SUCCESS},
+		SignerAddress: signerAddress,
+	}, nil
 }
 
 // CheckBlockAvailability samples shares from the underlying data availability layer
 func (d *DataAvailabilityLightClient) CheckBlockAvailability(ctx context.Context, req *dalc.CheckBlockAvailabilityRequest) (*dalc.CheckBlockAvailabilityResponse, error) {
-	extHeader, err := d.hstore.GetByHeight(ctx, req.DataLayerHeight)
-	if err != nil {
-		return nil, err
-	}
-
-	err = d.ss.SharesAvailable(ctx, extHeader.DAH)
+	start := time.Now()
+	err := d.retriever.SharesAvailable(ctx, req.DataLayerHeight)
+	d.metrics.observeAvailability(start, err == nil)
 	switch err {
 	case nil:
 		return &dalc.CheckBlockAvailabilityResponse{
@@ -116,49 +287,180 @@ func (d *DataAvailabilityLightClient) CheckBlockAvailability(ctx context.Context
 }
 
 func (d *DataAvailabilityLightClient) RetrieveBlocks(ctx context.Context, req *dalc.RetrieveBlocksRequest) (*dalc.RetrieveBlocksResponse, error) {
-	extHeader, err := d.hstore.GetByHeight(ctx, req.DataLayerHeight)
+	start := time.Now()
+	// todo include namespace inside the request, not preconfigured
+	rawShares, err := d.retriever.GetSharesByNamespace(ctx, req.DataLayerHeight, d.namespace)
 	if err != nil {
 		return nil, err
 	}
 
-	// todo include namespace inside the request, not preconfigured
-	shares, err := d.ss.GetSharesByNamespace(ctx, extHeader.DAH, d.namespace)
+	blocks, err := decodeBlocks(ctx, rawShares)
+	d.metrics.observeRetrieval(start, len(blocks), err)
 	if err != nil {
 		return nil, err
 	}
 
-	rawShares := make([][]byte, len(shares))
-	for i, share := range shares {
-		rawShares[i] = share.Data()
+	return &dalc.RetrieveBlocksResponse{
+		Result: &dalc.DAResponse{
+			Code: dalc.StatusCode_STATUS_CODE_SUCCESS,
+		},
+		Blocks: blocks,
+	}, nil
+}
+
+// GetInfo describes the configuration of the dalc and the state of its signer
+// and celestia node. The fields that could not be queried are left empty and
+// reported in the result message.
+func (d *DataAvailabilityLightClient) GetInfo(ctx context.Context, req *dalc.GetInfoRequest) (*dalc.GetInfoResponse, error) {
+	resp := &dalc.GetInfoResponse{
+		Result:             &dalc.DAResponse{Code: dalc.StatusCode_STATUS_CODE_SUCCESS},
+		Version:            Version,
+		Namespace:          d.namespace,
+		ChainId:            d.blockSubmitter.config.ChainID,
+		Denom:              d.blockSubmitter.config.Denom,
+		PendingSubmissions: uint64(d.pendingCount()),
+	}
+
+	var errs []string
+	if d.blockSubmitter.signers != nil {
+		for _, acc := range d.blockSubmitter.signers.accounts {
+			address, err := acc.address()
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("signer %s: %s", acc.name, err))
+				continue
+			}
+			resp.SignerAddresses = append(resp.SignerAddresses, address)
+		}
+		if len(resp.SignerAddresses) > 0 {
+			resp.SignerAddress = resp.SignerAddresses[0]
+		}
+
+		// prefer the balance known to the monitor, which accounts for the
+		// fees paid since it was last queried
+		balance, ok := d.balance.Balance()
+		if !ok {
+			states, err := d.blockSubmitter.accountStates(ctx)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("balance: %s", err))
+			} else {
+				balance = lowestBalance(states)
+			}
+		}
+		if !balance.Amount.IsNil() {
+			resp.Balance = balance.Amount.String()
+		}
+		resp.LowBalance = d.balance.Low()
+	}
+
+	h, err := d.retriever.Head(ctx)
+	if err != nil {
+		errs = append(errs, fmt.Sprintf("head: %s", err))
+	} else {
+		resp.DataLayerHeight = h.Height
+		resp.Result.DataLayerHeight = h.Height
 	}
 
+	if len(errs) > 0 {
+		resp.Result.Code = dalc.StatusCode_STATUS_CODE_ERROR
+		resp.Result.Message = strings.Join(errs, "; ")
+	}
+	return resp, nil
+}
+
+// decodeBlocks parses the optimint blocks contained in the shares of a
+// namespace
+func decodeBlocks(ctx context.Context, rawShares [][]byte) ([]*optimint.Block, error) {
+	_, span := tracer.Start(ctx, "ParseMsgs", trace.WithAttributes(attribute.Int("shares", len(rawShares))))
 	msgs, err := coretypes.ParseMsgs(rawShares)
+	endSpan(span, err)
 	if err != nil {
 		return nil, err
 	}
 
+	_, span = tracer.Start(ctx, "UnmarshalBlocks", trace.WithAttributes(attribute.Int("messages", len(msgs.MessagesList))))
 	var blocks []*optimint.Block
 	for _, msg := range msgs.MessagesList {
 		var block optimint.Block
 		err = proto.Unmarshal(msg.Data, &block)
 		if err != nil {
+			endSpan(span, err)
 			return nil, err
 		}
 		blocks = append(blocks, &block)
 	}
-
-	return &dalc.RetrieveBlocksResponse{
-		Result: &dalc.DAResponse{
-			Code: dalc.StatusCode_STATUS_CODE_SUCCESS,
-		},
-		Blocks: blocks,
-	}, nil
+	endSpan(span, nil)
+	return blocks, nil
 }
 
+// Start starts monitoring the balance of the signer account
 func (d *DataAvailabilityLightClient) Start(ctx context.Context) error {
+	d.balance.start()
 	return nil
 }
 
+// Stop stops monitoring the balance of the signer account and reports the
+// blocks whose submission was interrupted, which happens when the server is
+// stopped before their submission completed
 func (d *DataAvailabilityLightClient) Stop(ctx context.Context) error {
-	return nil
+	d.balance.stop()
+	d.pendingMtx.Lock()
+	heights := d.interrupted
+	d.pendingMtx.Unlock()
+	if len(heights) == 0 {
+		heights = d.pendingSubmissions()
+	}
+	if len(heights) == 0 {
+		return nil
+	}
+	log.Warnw("stopped while submitting blocks, they may or may not be included in celestia", "heights", heights)
+	return fmt.Errorf("dalc: interrupted submission of blocks at heights %v", heights)
+}
+
+// interruptSubmissions records the blocks currently being submitted as
+// interrupted, so that they are reported by Stop once their requests were
+// cancelled
+func (d *DataAvailabilityLightClient) interruptSubmissions() {
+	heights := d.pendingSubmissions()
+	d.pendingMtx.Lock()
+	defer d.pendingMtx.Unlock()
+	d.interrupted = heights
+}
+
+func (d *DataAvailabilityLightClient) trackSubmission(height uint64) {
+	d.pendingMtx.Lock()
+	defer d.pendingMtx.Unlock()
+	d.pending[height]++
+}
+
+func (d *DataAvailabilityLightClient) untrackSubmission(height uint64) {
+	d.pendingMtx.Lock()
+	defer d.pendingMtx.Unlock()
+	d.pending[height]--
+	if d.pending[height] <= 0 {
+		delete(d.pending, height)
+	}
+}
+
+// pendingCount returns the number of blocks currently being submitted
+func (d *DataAvailabilityLightClient) pendingCount() int {
+	d.pendingMtx.Lock()
+	defer d.pendingMtx.Unlock()
+	count := 0
+	for _, n := range d.pending {
+		count += n
+	}
+	return count
+}
+
+// pendingSubmissions returns the sorted heights of the blocks currently being
+// submitted
+func (d *DataAvailabilityLightClient) pendingSubmissions() []uint64 {
+	d.pendingMtx.Lock()
+	defer d.pendingMtx.Unlock()
+	heights := make([]uint64, 0, len(d.pending))
+	for height := range d.pending {
+		heights = append(heights, height)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	return heights
 }
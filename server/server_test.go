@@ -1,8 +1,11 @@
 package server
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/celestiaorg/dalc/proto/dalc"
 	"github.com/celestiaorg/dalc/proto/optimint"
 	"github.com/celestiaorg/nmt/namespace"
 	"github.com/gogo/protobuf/proto"
@@ -68,3 +71,27 @@ func generateOptmintBlock(hate uint64, id namespace.ID) *optimint.Block {
 		},
 	}
 }
+
+func TestGetInfo(t *testing.T) {
+	ctx := context.Background()
+	srv := testServer(t, &staticRetriever{}, time.Second)
+	srv.lc.trackSubmission(3)
+	srv.lc.trackSubmission(3)
+
+	info, err := srv.lc.GetInfo(ctx, &dalc.GetInfoRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, dalc.StatusCode_STATUS_CODE_SUCCESS, info.Result.Code)
+	assert.Equal(t, srv.lc.namespace, info.Namespace)
+	assert.Equal(t, uint64(1), info.DataLayerHeight)
+	assert.Equal(t, uint64(2), info.PendingSubmissions)
+
+	// failures to query the node are reported with the rest of the info
+	srv = testServer(t, &blockingRetriever{}, time.Second)
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	info, err = srv.lc.GetInfo(cancelled, &dalc.GetInfoRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, dalc.StatusCode_STATUS_CODE_ERROR, info.Result.Code)
+	assert.Contains(t, info.Result.Message, "head")
+	assert.Equal(t, srv.lc.namespace, info.Namespace)
+}
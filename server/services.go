@@ -2,53 +2,83 @@ package server
 
 import (
 	"context"
-	"net"
 
+	"github.com/celestiaorg/celestia-node/libs/keystore"
 	"github.com/celestiaorg/celestia-node/node"
 	"github.com/celestiaorg/celestia-node/service/header"
 	"github.com/celestiaorg/celestia-node/service/share"
 	"github.com/celestiaorg/dalc/config"
+	"github.com/spf13/pflag"
 	"go.uber.org/fx"
-	"google.golang.org/grpc"
 )
 
 func DALC(
 	cfg config.ServerConfig,
 	ss share.Service,
 	hstore header.Store,
-) (*grpc.Server, error) {
-	return New(cfg, ss, hstore)
+	ks keystore.Keystore,
+) (*Server, error) {
+	return New(cfg, ss, hstore, ks)
 }
 
-func LoadConfig(store node.Store) (func() config.ServerConfig, error) {
-	cfg, err := config.Load(store.Path())
+// LoadConfig loads the config of the node store, overridden by the DALC_*
+// environment variables and the flags set, if any. The config is migrated
+// first if it was written by an older version of the dalc.
+func LoadConfig(store node.Store, flags *pflag.FlagSet) (func() config.ServerConfig, error) {
+	var cfg config.ServerConfig
+	err := MigrateConfig(store.Path())
+	if err == nil {
+		cfg, _, err = config.LoadEffective(store.Path(), flags)
+	}
+	if err == nil {
+		err = cfg.Validate()
+	}
 	if err != nil {
 		return func() config.ServerConfig { return config.ServerConfig{} }, err
 	}
 	return func() config.ServerConfig { return cfg }, nil
 }
 
-func GRPCServer(lc fx.Lifecycle, srv *grpc.Server, cfg config.ServerConfig) node.PluginResult {
+// MigrateConfig migrates the config found in path if it was written by an
+// older version of the dalc, backing it up first
+func MigrateConfig(path string) error {
+	m, err := config.PlanMigration(path)
+	if err != nil || !m.Outdated() {
+		return err
+	}
+	backup, err := m.Apply(path)
+	if err != nil {
+		return err
+	}
+	log.Infow("Migrated dalc config", "from", m.From, "to", config.Version, "backup", backup)
+	return nil
+}
+
+// GRPCServer ties the lifecycle of the dalc grpc server to the one of the
+// celestia-node. If the server stops serving unexpectedly, the node is asked to
+// shut down and the error is returned when the node is stopped.
+func GRPCServer(lc fx.Lifecycle, shutdowner fx.Shutdowner, srv *Server) node.PluginResult {
 	lc.Append(
 		fx.Hook{
 			OnStart: func(c context.Context) error {
-				// listen to the client
-				lis, err := net.Listen("tcp", cfg.ListenAddr)
+				err := srv.Start(c)
 				if err != nil {
 					return err
 				}
 				go func() {
-					err = srv.Serve(lis)
+					err, ok := <-srv.Err()
+					if !ok {
+						return
+					}
+					log.Errorw("dalc stopped serving, shutting down", "err", err)
+					err = shutdowner.Shutdown()
 					if err != nil {
-						log.Fatal(err)
+						log.Errorw("requesting shutdown", "err", err)
 					}
 				}()
 				return nil
 			},
-			OnStop: func(c context.Context) error {
-				srv.Stop()
-				return nil
-			},
+			OnStop: srv.Stop,
 		},
 	)
 	return node.PluginResult(struct{}{})
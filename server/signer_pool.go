@@ -0,0 +1,141 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	apptypes "github.com/celestiaorg/celestia-app/x/payment/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/tendermint/spm/cosmoscmd"
+	"google.golang.org/grpc"
+)
+
+// signerAccount is a keyring account signing transactions. Its account number
+// and sequence are queried once and then tracked locally between submissions.
+type signerAccount struct {
+	name   string
+	signer *apptypes.KeyringSigner
+
+	// synced is false until the account number and sequence are queried, and
+	// after a submission failed and may have left the sequence out of sync
+	synced   bool
+	sequence uint64
+}
+
+// address returns the bech32 address of the account
+func (acc *signerAccount) address() (string, error) {
+	info, err := acc.signer.Key(acc.name)
+	if err != nil {
+		return "", err
+	}
+	return info.GetAddress().String(), nil
+}
+
+// sync queries the account number and sequence of the account
+func (acc *signerAccount) sync(ctx context.Context, conn *grpc.ClientConn, encCfg cosmoscmd.EncodingConfig) error {
+	address, err := acc.address()
+	if err != nil {
+		return err
+	}
+	number, sequence, err := apptypes.QueryAccount(ctx, conn, encCfg, address)
+	if err != nil {
+		return err
+	}
+	acc.signer.SetAccountNumber(number)
+	acc.setSequence(sequence)
+	acc.synced = true
+	return nil
+}
+
+func (acc *signerAccount) setSequence(sequence uint64) {
+	acc.sequence = sequence
+	acc.signer.SetSequence(sequence)
+}
+
+// signerPool hands out the accounts of the keyring to the submissions, so
+// that blocks are submitted in parallel while each account is used by a single
+// submission at a time. Accounts are handed out in the order they are released,
+// which balances the submissions across them.
+type signerPool struct {
+	accounts []*signerAccount
+
+	// mtx guards idle, the accounts that are not in use in the order they were
+	// released, and released, which is closed when an account is released
+	mtx      sync.Mutex
+	idle     []*signerAccount
+	released chan struct{}
+}
+
+func newSignerPool(ring keyring.Keyring, names []string, chainID string) (*signerPool, error) {
+	if len(names) == 0 {
+		return nil, errors.New("dalc: no keyring account to sign transactions with")
+	}
+	pool := &signerPool{released: make(chan struct{})}
+	for _, name := range names {
+		acc := &signerAccount{
+			name:   name,
+			signer: apptypes.NewKeyringSigner(ring, name, chainID),
+		}
+		pool.accounts = append(pool.accounts, acc)
+		pool.idle = append(pool.idle, acc)
+	}
+	return pool, nil
+}
+
+// acquire waits until an account is idle and hands it out. It must be released
+// once the transaction it signed was broadcast. If canPay is set, the accounts
+// it returns an error for are skipped. Once none of the accounts can pay, the
+// error is returned along with the account it was returned for, which is not
+// handed out.
+func (p *signerPool) acquire(ctx context.Context, canPay func(name string) error) (*signerAccount, error) {
+	for {
+		p.mtx.Lock()
+		for i, acc := range p.idle {
+			if canPay == nil || canPay(acc.name) == nil {
+				p.idle = append(p.idle[:i:i], p.idle[i+1:]...)
+				p.mtx.Unlock()
+				return acc, nil
+			}
+		}
+		if canPay != nil {
+			if refused, err := p.noneCanPay(canPay); err != nil {
+				p.mtx.Unlock()
+				return refused, err
+			}
+		}
+		released := p.released
+		p.mtx.Unlock()
+
+		select {
+		case <-released:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// noneCanPay returns the first account and its error if canPay fails for all
+// of them
+func (p *signerPool) noneCanPay(canPay func(name string) error) (*signerAccount, error) {
+	var first *signerAccount
+	var firstErr error
+	for _, acc := range p.accounts {
+		err := canPay(acc.name)
+		if err == nil {
+			return nil, nil
+		}
+		if first == nil {
+			first, firstErr = acc, err
+		}
+	}
+	return first, firstErr
+}
+
+func (p *signerPool) release(acc *signerAccount) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.idle = append(p.idle, acc)
+	close(p.released)
+	p.released = make(chan struct{})
+}
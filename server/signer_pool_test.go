@@ -0,0 +1,71 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignerPool(t *testing.T) {
+	ctx := context.Background()
+	names := []string{"first", "second"}
+	pool, err := newSignerPool(generateKeyring(t, names...), names, "test")
+	require.NoError(t, err)
+
+	first, err := pool.acquire(ctx, nil)
+	require.NoError(t, err)
+	second, err := pool.acquire(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "first", first.name)
+	assert.Equal(t, "second", second.name)
+	assert.False(t, first.synced)
+
+	addr, err := first.address()
+	require.NoError(t, err)
+	assert.NotEmpty(t, addr)
+
+	// every account is in use
+	timeoutCtx, cancel := context.WithTimeout(ctx, time.Millisecond*10)
+	defer cancel()
+	_, err = pool.acquire(timeoutCtx, nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// accounts are handed out in the order they are released
+	pool.release(second)
+	pool.release(first)
+	acc, err := pool.acquire(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "second", acc.name)
+
+	// the accounts that can not pay are skipped
+	pool.release(acc)
+	canPay := func(name string) error {
+		if name == "second" {
+			return ErrInsufficientFunds
+		}
+		return nil
+	}
+	acc, err = pool.acquire(ctx, canPay)
+	require.NoError(t, err)
+	assert.Equal(t, "first", acc.name)
+
+	// and waited for if another account can pay once released
+	acquired := make(chan *signerAccount)
+	go func() {
+		acc, _ := pool.acquire(ctx, canPay)
+		acquired <- acc
+	}()
+	pool.release(acc)
+	assert.Equal(t, "first", (<-acquired).name)
+
+	// the submission is refused once none can pay
+	refused, err := pool.acquire(ctx, func(name string) error { return ErrInsufficientFunds })
+	assert.ErrorIs(t, err, ErrInsufficientFunds)
+	assert.Equal(t, "first", refused.name)
+
+	_, err = newSignerPool(generateKeyring(t), nil, "test")
+	assert.Error(t, err)
+}
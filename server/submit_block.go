@@ -2,29 +2,40 @@ package server
 
 import (
 	"context"
+	"fmt"
+	"sync/atomic"
 
 	"github.com/celestiaorg/celestia-app/app"
 	"github.com/celestiaorg/celestia-app/x/payment/types"
 	apptypes "github.com/celestiaorg/celestia-app/x/payment/types"
 	"github.com/celestiaorg/dalc/config"
 	"github.com/celestiaorg/dalc/proto/optimint"
+	"github.com/celestiaorg/dalc/signer"
 	"github.com/cosmos/cosmos-sdk/client"
-	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/gogo/protobuf/proto"
 	"github.com/tendermint/spm/cosmoscmd"
 	"github.com/tendermint/tendermint/pkg/consts"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 )
 
-func newBlockSubmitter(cfg config.BlockSubmitterConfig, conn *grpc.ClientConn, ring keyring.Keyring) (blockSubmitter, error) {
+func newBlockSubmitter(cfg config.BlockSubmitterConfig, conn *grpc.ClientConn, s signer.Signer) (blockSubmitter, error) {
 	encCfg := cosmoscmd.MakeEncodingConfig(app.ModuleBasics)
-	signer := apptypes.NewKeyringSigner(ring, cfg.KeyringAccName, cfg.ChainID)
+	signers, err := newSignerPool(signer.Keyring(s), cfg.Accounts(), cfg.ChainID)
+	if err != nil {
+		return blockSubmitter{}, err
+	}
 
+	fees := &atomic.Value{}
+	fees.Store(submitFees{amount: cfg.FeeAmount, gasLimit: cfg.GasLimit})
 	return blockSubmitter{
 		config:      cfg,
-		signer:      signer,
+		currentFees: fees,
+		signers:     signers,
 		celestiaRPC: conn,
 		encCfg:      encCfg,
 	}, nil
@@ -33,14 +44,35 @@ func newBlockSubmitter(cfg config.BlockSubmitterConfig, conn *grpc.ClientConn, r
 // blockSubmitter submits optimint blocks to celestia
 type blockSubmitter struct {
 	config config.BlockSubmitterConfig
-	signer *apptypes.KeyringSigner
+	// currentFees holds the submitFees, which replace the fee amount and gas
+	// limit of the config when reloaded
+	currentFees *atomic.Value
+	signers     *signerPool
 
 	encCfg cosmoscmd.EncodingConfig
 
 	celestiaRPC *grpc.ClientConn
 }
 
-func (bs *blockSubmitter) buildPayForMessage(block *optimint.Block) (*apptypes.MsgWirePayForMessage, error) {
+// submitFees are the fee amount and gas limit of the transactions submitting
+// blocks. They are read once per submission, so that the share commitments and
+// the transaction are signed with the same ones.
+type submitFees struct {
+	amount   uint64
+	gasLimit uint64
+}
+
+// fees returns the current fee amount and gas limit
+func (bs *blockSubmitter) fees() submitFees {
+	return bs.currentFees.Load().(submitFees)
+}
+
+// setFees changes the fee amount and gas limit of the next submissions
+func (bs *blockSubmitter) setFees(amount, gasLimit uint64) {
+	bs.currentFees.Store(submitFees{amount: amount, gasLimit: gasLimit})
+}
+
+func (bs *blockSubmitter) buildPayForMessage(signer *apptypes.KeyringSigner, fees submitFees, block *optimint.Block) (*apptypes.MsgWirePayForMessage, error) {
 	// TODO(evan): change this when implementing ADR007
 	message, err := proto.Marshal(block)
 	if err != nil {
@@ -53,16 +85,16 @@ func (bs *blockSubmitter) buildPayForMessage(block *optimint.Block) (*apptypes.M
 	}
 
 	err = pfmMsg.SignShareCommitments(
-		bs.signer,
+		signer,
 		types.SetFeeAmount(
 			sdk.NewCoins(
 				sdk.NewCoin(
 					bs.config.Denom,
-					sdk.NewInt(int64(bs.config.FeeAmount)),
+					sdk.NewInt(int64(fees.amount)),
 				),
 			),
 		),
-		types.SetGasLimit(bs.config.GasLimit),
+		types.SetGasLimit(fees.gasLimit),
 	)
 	if err != nil {
 		return nil, err
@@ -71,37 +103,95 @@ func (bs *blockSubmitter) buildPayForMessage(block *optimint.Block) (*apptypes.M
 	return pfmMsg, nil
 }
 
-// SubmitBlock prepares a WirePayForMessage that contains the provided block data
-func (bs *blockSubmitter) SubmitBlock(ctx context.Context, block *optimint.Block) (*tx.BroadcastTxResponse, error) {
-	err := bs.signer.QueryAccountNumber(ctx, bs.celestiaRPC)
+// SubmitBlock prepares a WirePayForMessage that contains the provided block
+// data, signs it with the next idle account and broadcasts it. The accounts
+// canPay returns an error for are skipped if it is set. It returns the account
+// that signed the transaction, or the one refused when none can pay.
+func (bs *blockSubmitter) SubmitBlock(
+	ctx context.Context,
+	block *optimint.Block,
+	canPay func(name string) error,
+) (*tx.BroadcastTxResponse, *signerAccount, error) {
+	acc, err := bs.signers.acquire(ctx, canPay)
 	if err != nil {
+		return nil, acc, err
+	}
+	defer bs.signers.release(acc)
+
+	resp, err := bs.submitBlock(ctx, acc, block)
+	if err != nil || resp.TxResponse == nil || resp.TxResponse.Code != 0 {
+		// the sequence is only known to be incremented by successful
+		// transactions, query it again before the next one
+		acc.synced = false
+	} else {
+		acc.setSequence(acc.sequence + 1)
+	}
+	return resp, acc, err
+}
+
+func (bs *blockSubmitter) submitBlock(ctx context.Context, acc *signerAccount, block *optimint.Block) (*tx.BroadcastTxResponse, error) {
+	if !acc.synced {
+		spanCtx, span := tracer.Start(ctx, "QueryAccountNumber", trace.WithAttributes(attribute.String("account", acc.name)))
+		err := acc.sync(spanCtx, bs.celestiaRPC, bs.encCfg)
+		endSpan(span, err)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the signer panics while building the transaction if it can not look up
+	// the key of the account, which is fetched and cached by remote signers
+	if _, err := acc.address(); err != nil {
 		return nil, err
 	}
 
-	pfmMsg, err := bs.buildPayForMessage(block)
+	// signs the share commitments of every square size
+	_, span := tracer.Start(ctx, "BuildPayForMessage", trace.WithAttributes(
+		attribute.Int("square_sizes", len(bs.squareSizes())),
+	))
+	fees := bs.fees()
+	pfmMsg, err := bs.buildPayForMessage(acc.signer, fees, block)
+	endSpan(span, err)
 	if err != nil {
 		return nil, err
 	}
 
-	wirePFMtx, err := bs.signer.BuildSignedTx(bs.newTxBuilder(), pfmMsg)
+	_, span = tracer.Start(ctx, "SignTx", trace.WithAttributes(
+		attribute.String("account", acc.name),
+		attribute.Int64("sequence", int64(acc.sequence)),
+	))
+	wirePFMtx, err := acc.signer.BuildSignedTx(bs.newTxBuilder(acc.signer, fees), pfmMsg)
 	if err != nil {
+		endSpan(span, err)
 		return nil, err
 	}
 
 	rawTx, err := bs.encCfg.TxConfig.TxEncoder()(wirePFMtx)
+	endSpan(span, err)
 	if err != nil {
 		return nil, err
 	}
 
+	var spanCtx context.Context
 	txClient := tx.NewServiceClient(bs.celestiaRPC)
 
-	return txClient.BroadcastTx(
-		ctx,
+	spanCtx, span = tracer.Start(ctx, "BroadcastTx", trace.WithAttributes(attribute.Int("tx_size", len(rawTx))))
+	resp, err := txClient.BroadcastTx(
+		spanCtx,
 		&tx.BroadcastTxRequest{
 			Mode:    tx.BroadcastMode(1),
 			TxBytes: rawTx,
 		},
 	)
+	if err == nil && resp.TxResponse != nil {
+		span.SetAttributes(
+			attribute.Int64("code", int64(resp.TxResponse.Code)),
+			attribute.Int64("gas_used", resp.TxResponse.GasUsed),
+			attribute.String("tx_hash", resp.TxResponse.TxHash),
+		)
+	}
+	endSpan(span, err)
+	return resp, err
 }
 
 func (bs *blockSubmitter) squareSizes() []uint64 {
@@ -115,12 +205,63 @@ func (bs *blockSubmitter) squareSizes() []uint64 {
 }
 
 // todo: refactor this out
-func (bs *blockSubmitter) newTxBuilder() client.TxBuilder {
-	// todo: don't hardcode the gas limit and fees
-	builder := bs.signer.NewTxBuilder()
-	fee := sdk.Coins{sdk.NewCoin(bs.config.Denom, sdk.NewInt(int64(bs.config.FeeAmount)))}
+func (bs *blockSubmitter) newTxBuilder(signer *apptypes.KeyringSigner, fees submitFees) client.TxBuilder {
+	builder := signer.NewTxBuilder()
+	fee := sdk.Coins{sdk.NewCoin(bs.config.Denom, sdk.NewInt(int64(fees.amount)))}
 	builder.SetFeeAmount(fee)
-	builder.SetGasLimit(bs.config.GasLimit)
+	builder.SetGasLimit(fees.gasLimit)
 
 	return builder
 }
+
+// accountState is the balance in the configured denomination and the sequence
+// of an account signing the transactions
+type accountState struct {
+	name     string
+	balance  sdk.Coin
+	sequence uint64
+}
+
+// accountStates queries the state of every account signing the transactions
+func (bs *blockSubmitter) accountStates(ctx context.Context) ([]accountState, error) {
+	states := make([]accountState, 0, len(bs.signers.accounts))
+	for _, acc := range bs.signers.accounts {
+		state, err := bs.accountState(ctx, acc)
+		if err != nil {
+			return nil, fmt.Errorf("account %s: %w", acc.name, err)
+		}
+		states = append(states, state)
+	}
+	return states, nil
+}
+
+func (bs *blockSubmitter) accountState(ctx context.Context, acc *signerAccount) (accountState, error) {
+	state := accountState{name: acc.name}
+	address, err := acc.address()
+	if err != nil {
+		return state, err
+	}
+
+	_, state.sequence, err = apptypes.QueryAccount(ctx, bs.celestiaRPC, bs.encCfg, address)
+	if err != nil {
+		return state, err
+	}
+
+	resp, err := banktypes.NewQueryClient(bs.celestiaRPC).Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: address,
+		Denom:   bs.config.Denom,
+	})
+	if err != nil {
+		return state, err
+	}
+	state.balance = sdk.NewCoin(bs.config.Denom, sdk.ZeroInt())
+	if resp.Balance != nil {
+		state.balance = *resp.Balance
+	}
+	return state, nil
+}
+
+// fee returns the fee paid by each transaction submitting a block
+func (bs *blockSubmitter) fee() sdk.Coin {
+	return sdk.NewCoin(bs.config.Denom, sdk.NewInt(int64(bs.fees().amount)))
+}
@@ -1,10 +1,14 @@
 package server
 
 import (
+	"context"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/celestiaorg/dalc/config"
 	"github.com/celestiaorg/dalc/proto/optimint"
+	"github.com/celestiaorg/dalc/signer"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/stretchr/testify/assert"
@@ -25,7 +29,7 @@ func TestBuildPFM(t *testing.T) {
 			Height: 1,
 		},
 	}
-	pfm, err := bs.buildPayForMessage(block)
+	pfm, err := bs.buildPayForMessage(bs.signers.accounts[0].signer, bs.fees(), block)
 	require.NoError(t, err)
 
 	signerInfo, err := kr.Key(cfg.KeyringAccName)
@@ -38,6 +42,29 @@ func TestBuildPFM(t *testing.T) {
 	assert.Contains(t, string(pfm.Message), string(rawBlock))
 }
 
+func TestBuildPFMRemoteSigner(t *testing.T) {
+	cfg := config.DefaultBlockSubmitterConfig()
+	kr := generateKeyring(t, cfg.KeyringAccName)
+	srv := httptest.NewServer(signer.NewHandler(kr, ""))
+	t.Cleanup(srv.Close)
+
+	bs, err := newBlockSubmitter(cfg, nil, signer.NewRemote(srv.URL, "", time.Second))
+	require.NoError(t, err)
+	acc := bs.signers.accounts[0]
+	pfm, err := bs.buildPayForMessage(acc.signer, bs.fees(), generateOptmintBlock(1, []byte{1, 2, 3, 4, 5, 6, 7, 8}))
+	require.NoError(t, err)
+
+	signerInfo, err := kr.Key(cfg.KeyringAccName)
+	require.NoError(t, err)
+	assert.Equal(t, signerInfo.GetAddress().String(), pfm.Signer)
+	for _, commitment := range pfm.MessageShareCommitment {
+		assert.NotEmpty(t, commitment.Signature)
+	}
+
+	_, err = acc.signer.BuildSignedTx(bs.newTxBuilder(acc.signer, bs.fees()), pfm)
+	require.NoError(t, err)
+}
+
 func testBlockSubmitter(t *testing.T, cfg config.BlockSubmitterConfig) (blockSubmitter, keyring.Keyring) { //nolint
 	t.Helper()
 	kr := generateKeyring(t, cfg.KeyringAccName)
@@ -57,3 +84,33 @@ func generateKeyring(t *testing.T, accts ...string) keyring.Keyring {
 
 	return kb
 }
+
+func TestSubmitBlockRemoteSignerUnreachable(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.DefaultBlockSubmitterConfig()
+	kr := generateKeyring(t, cfg.KeyringAccName)
+	srv := httptest.NewServer(signer.NewHandler(kr, ""))
+
+	bs, err := newBlockSubmitter(cfg, nil, signer.NewRemote(srv.URL, "", time.Second))
+	require.NoError(t, err)
+	acc := bs.signers.accounts[0]
+	acc.synced = true
+	srv.Close()
+
+	// the key of the account can not be fetched
+	_, _, err = bs.SubmitBlock(ctx, generateOptmintBlock(1, []byte{1, 2, 3, 4, 5, 6, 7, 8}), nil)
+	assert.Error(t, err)
+
+	// the key is cached but signing fails
+	srv = httptest.NewServer(signer.NewHandler(kr, ""))
+	bs, err = newBlockSubmitter(cfg, nil, signer.NewRemote(srv.URL, "", time.Second))
+	require.NoError(t, err)
+	acc = bs.signers.accounts[0]
+	_, err = acc.address()
+	require.NoError(t, err)
+	acc.synced = true
+	srv.Close()
+
+	_, _, err = bs.SubmitBlock(ctx, generateOptmintBlock(1, []byte{1, 2, 3, 4, 5, 6, 7, 8}), nil)
+	assert.Error(t, err)
+}
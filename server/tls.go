@@ -0,0 +1,151 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/celestiaorg/dalc/config"
+)
+
+// certReloader provides the TLS configuration of the grpc server. The
+// certificate, key and client CAs are read again whenever one of their files
+// changes, so that they can be rotated without restarting the server.
+type certReloader struct {
+	cfg config.TLSConfig
+
+	mtx      sync.Mutex
+	modTimes []time.Time
+	tlsCfg   *tls.Config
+}
+
+func newCertReloader(cfg config.TLSConfig) (*certReloader, error) {
+	cr := &certReloader{cfg: cfg}
+	_, err := cr.config()
+	if err != nil {
+		return nil, err
+	}
+	return cr, nil
+}
+
+// TLSConfig returns the TLS configuration of a server negotiating one of the
+// provided application protocols, such as "h2" for the grpc server. The config
+// returned for each connection replaces the returned one, so it sets them too.
+func (cr *certReloader) TLSConfig(nextProtos ...string) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: nextProtos,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			tlsCfg, err := cr.config()
+			if err != nil {
+				return nil, err
+			}
+			tlsCfg = tlsCfg.Clone()
+			tlsCfg.NextProtos = nextProtos
+			return tlsCfg, nil
+		},
+	}
+}
+
+// config returns the current TLS configuration, reloading it first if any of
+// the files changed since they were last read
+func (cr *certReloader) config() (*tls.Config, error) {
+	cr.mtx.Lock()
+	defer cr.mtx.Unlock()
+
+	modTimes, err := cr.statFiles()
+	if err != nil {
+		// keep serving the last valid configuration while files are replaced
+		if cr.tlsCfg != nil {
+			log.Warnw("checking tls files for changes", "err", err)
+			return cr.tlsCfg, nil
+		}
+		return nil, err
+	}
+	if cr.tlsCfg != nil && equalTimes(modTimes, cr.modTimes) {
+		return cr.tlsCfg, nil
+	}
+
+	tlsCfg, err := loadServerTLS(cr.cfg)
+	if err != nil {
+		if cr.tlsCfg != nil {
+			log.Errorw("reloading tls files, keeping previous certificate", "err", err)
+			return cr.tlsCfg, nil
+		}
+		return nil, err
+	}
+	if cr.tlsCfg != nil {
+		log.Infow("reloaded tls certificate", "cert", cr.cfg.CertFile)
+	}
+	cr.tlsCfg = tlsCfg
+	cr.modTimes = modTimes
+	return cr.tlsCfg, nil
+}
+
+func (cr *certReloader) statFiles() ([]time.Time, error) {
+	files := []string{cr.cfg.CertFile, cr.cfg.KeyFile}
+	if cr.cfg.ClientCAFile != "" {
+		files = append(files, cr.cfg.ClientCAFile)
+	}
+
+	modTimes := make([]time.Time, len(files))
+	for i, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, err
+		}
+		modTimes[i] = info.ModTime()
+	}
+	return modTimes, nil
+}
+
+func loadServerTLS(cfg config.TLSConfig) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("loading tls certificate: %w", err)
+	}
+
+	tlsCfg := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+	}
+
+	if cfg.ClientCAFile != "" {
+		pool, err := loadCertPool(cfg.ClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsCfg.ClientCAs = pool
+		tlsCfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return tlsCfg, nil
+}
+
+func loadCertPool(file string) (*x509.CertPool, error) {
+	rawCAs, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("loading certificate authorities: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(rawCAs) {
+		return nil, errors.New("no valid certificate authorities found in " + file)
+	}
+	return pool, nil
+}
+
+func equalTimes(a, b []time.Time) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,239 @@
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/peer"
+
+	"github.com/celestiaorg/dalc/config"
+)
+
+func TestMutualTLS(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	cfg := config.TLSConfig{
+		CertFile:     filepath.Join(dir, "server.crt"),
+		KeyFile:      filepath.Join(dir, "server.key"),
+		ClientCAFile: filepath.Join(dir, "ca.crt"),
+	}
+	ca.writeCert(t, cfg.CertFile, cfg.KeyFile, 1)
+	writePEM(t, cfg.ClientCAFile, "CERTIFICATE", ca.cert.Raw)
+
+	reloader, err := newCertReloader(cfg)
+	require.NoError(t, err)
+	addr := serveGRPCTLS(t, reloader.TLSConfig("h2"))
+
+	clientCfg := &tls.Config{
+		RootCAs:    ca.pool(),
+		ServerName: "localhost",
+		MaxVersion: tls.VersionTLS12,
+	}
+
+	// clients without a certificate are rejected
+	_, err = checkHealthTLS(ctx, addr, clientCfg)
+	assert.Error(t, err)
+
+	clientCert := ca.issue(t, 2)
+	clientCfg.Certificates = []tls.Certificate{clientCert}
+	state, err := checkHealthTLS(ctx, addr, clientCfg)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1), state.PeerCertificates[0].SerialNumber)
+	assert.Equal(t, "h2", state.NegotiatedProtocol)
+
+	// rotating the certificate is picked up by new connections
+	ca.writeCert(t, cfg.CertFile, cfg.KeyFile, 3)
+	future := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(cfg.CertFile, future, future))
+
+	state, err = checkHealthTLS(ctx, addr, clientCfg)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(3), state.PeerCertificates[0].SerialNumber)
+	assert.Equal(t, "h2", state.NegotiatedProtocol)
+}
+
+func TestCertReloaderNextProtos(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	cfg := config.TLSConfig{
+		CertFile: filepath.Join(dir, "server.crt"),
+		KeyFile:  filepath.Join(dir, "server.key"),
+	}
+	ca.writeCert(t, cfg.CertFile, cfg.KeyFile, 1)
+	reloader, err := newCertReloader(cfg)
+	require.NoError(t, err)
+
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", reloader.TLSConfig("h2", "http/1.1"))
+	require.NoError(t, err)
+	srv := &http.Server{Handler: http.NotFoundHandler(), ReadHeaderTimeout: time.Second}
+	go srv.Serve(lis) //nolint:errcheck
+	t.Cleanup(func() { srv.Close() })
+
+	// the gateway serves both http/2 and http/1.1 clients
+	for _, proto := range []string{"h2", "http/1.1"} {
+		conn, err := tls.Dial("tcp", lis.Addr().String(), &tls.Config{
+			RootCAs:    ca.pool(),
+			ServerName: "localhost",
+			NextProtos: []string{proto},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, proto, conn.ConnectionState().NegotiatedProtocol)
+		conn.Close()
+	}
+}
+
+func TestCertReloaderInvalidFiles(t *testing.T) {
+	dir := t.TempDir()
+	_, err := newCertReloader(config.TLSConfig{
+		CertFile: filepath.Join(dir, "missing.crt"),
+		KeyFile:  filepath.Join(dir, "missing.key"),
+	})
+	assert.Error(t, err)
+}
+
+// serveTLS accepts connections and completes their handshake until the test
+// ends
+func serveTLS(t *testing.T, tlsCfg *tls.Config) string {
+	t.Helper()
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", tlsCfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { lis.Close() })
+
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			go func(conn net.Conn) {
+				defer conn.Close()
+				conn.(*tls.Conn).Handshake() //nolint:errcheck
+			}(conn)
+		}
+	}()
+	return lis.Addr().String()
+}
+
+// serveGRPCTLS serves the grpc health service over TLS until the test ends
+func serveGRPCTLS(t *testing.T, tlsCfg *tls.Config) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsCfg)))
+	healthpb.RegisterHealthServer(srv, health.NewServer())
+	go srv.Serve(lis) //nolint:errcheck
+	t.Cleanup(srv.Stop)
+	return lis.Addr().String()
+}
+
+// checkHealthTLS calls the grpc health service over a new TLS connection and
+// returns the state of the connection
+func checkHealthTLS(ctx context.Context, addr string, tlsCfg *tls.Config) (tls.ConnectionState, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*5)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(credentials.NewTLS(tlsCfg)))
+	if err != nil {
+		return tls.ConnectionState{}, err
+	}
+	defer conn.Close()
+
+	var p peer.Peer
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{}, grpc.Peer(&p), grpc.WaitForReady(false))
+	if err != nil {
+		return tls.ConnectionState{}, err
+	}
+	return p.AuthInfo.(credentials.TLSInfo).State, nil
+}
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(0),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	raw, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(raw)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key}
+}
+
+func (ca *testCA) pool() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+	return pool
+}
+
+// issue creates a certificate for localhost that is signed by the CA
+func (ca *testCA) issue(t *testing.T, serial int64) tls.Certificate {
+	t.Helper()
+	certPEM, keyPEM := ca.issuePEM(t, serial)
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+	return cert
+}
+
+func (ca *testCA) writeCert(t *testing.T, certFile, keyFile string, serial int64) {
+	t.Helper()
+	certPEM, keyPEM := ca.issuePEM(t, serial)
+	require.NoError(t, os.WriteFile(certFile, certPEM, 0600))
+	require.NoError(t, os.WriteFile(keyFile, keyPEM, 0600))
+}
+
+func (ca *testCA) issuePEM(t *testing.T, serial int64) ([]byte, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	raw, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	rawKey, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: raw}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: rawKey})
+}
+
+func writePEM(t *testing.T, file, blockType string, data []byte) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: data}), 0600))
+}
@@ -0,0 +1,49 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coretypes "github.com/tendermint/tendermint/types"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/celestiaorg/dalc/proto/dalc"
+)
+
+func TestRetrieveBlocksSpans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { otel.SetTracerProvider(trace.NewNoopTracerProvider()) })
+
+	ns := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	rawBlock, err := generateOptmintBlock(1, ns).Marshal()
+	require.NoError(t, err)
+	msgs := coretypes.Messages{MessagesList: []coretypes.Message{{NamespaceID: ns, Data: rawBlock}}}
+	srv := testServer(t, &staticRetriever{shares: msgs.SplitIntoShares().RawShares()}, time.Second)
+
+	// the trace was started by optimint
+	parent := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	})
+	ctx := trace.ContextWithRemoteSpanContext(context.Background(), parent)
+
+	_, err = srv.lc.RetrieveBlocks(ctx, &dalc.RetrieveBlocksRequest{DataLayerHeight: 1})
+	require.NoError(t, err)
+
+	var names []string
+	for _, span := range recorder.Ended() {
+		names = append(names, span.Name())
+		assert.Equal(t, parent.TraceID(), span.SpanContext().TraceID())
+	}
+	assert.Equal(t, []string{"ParseMsgs", "UnmarshalBlocks"}, names)
+}
@@ -0,0 +1,94 @@
+package signer
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// maxRequestSize bounds the size of the sign requests, which hold the bytes of
+// a transaction
+const maxRequestSize = 8 << 20
+
+// NewHandler serves the keys of s to remote signers over HTTP. It is the
+// reference implementation of the signing daemon protocol. Requests must carry
+// token as a bearer token if it is not empty.
+func NewHandler(s Signer, token string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(keysEndpoint+"/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+			return
+		}
+		key, err := s.Key(strings.TrimPrefix(r.URL.Path, keysEndpoint+"/"))
+		if err != nil {
+			writeError(w, statusOf(err), err)
+			return
+		}
+		pubKey, err := cdc.MarshalInterfaceJSON(key.GetPubKey())
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		writeJSON(w, keyResponse{Name: key.GetName(), PubKey: pubKey})
+	})
+	mux.HandleFunc(signEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+			return
+		}
+		var req signRequest
+		err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestSize)).Decode(&req)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		sig, pub, err := s.SignByAddress(sdk.AccAddress(req.Address), req.Msg)
+		if err != nil {
+			writeError(w, statusOf(err), err)
+			return
+		}
+		pubKey, err := cdc.MarshalInterfaceJSON(pub)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		writeJSON(w, signResponse{Signature: sig, PubKey: pubKey})
+	})
+
+	if token == "" {
+		return mux
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			writeError(w, http.StatusUnauthorized, errors.New("invalid token"))
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// statusOf returns the http status reporting a keyring error
+func statusOf(err error) int {
+	if errors.Is(err, sdkerrors.ErrKeyNotFound) {
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(errorResponse{Error: err.Error()})
+}
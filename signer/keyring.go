@@ -0,0 +1,127 @@
+package signer
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/mattn/go-isatty"
+
+	"github.com/celestiaorg/dalc/config"
+)
+
+// OpenKeyring opens the keyring configured by cfg. The keyring is unlocked
+// before returning, so that the passphrase of the file and os backends is
+// read once, on opening. The passphrase is read from the environment variable,
+// then the passphrase file, and finally asked for if stdin is a terminal.
+func OpenKeyring(appName string, cfg config.KeyringConfig) (keyring.Keyring, error) {
+	if cfg.KeyringBackend != keyring.BackendFile && cfg.KeyringBackend != keyring.BackendOS {
+		return openKeyring(appName, cfg, strings.NewReader(""))
+	}
+
+	pass, err := Passphrase(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if pass == "" {
+		if !isatty.IsTerminal(os.Stdin.Fd()) && !isatty.IsCygwinTerminal(os.Stdin.Fd()) {
+			return nil, fmt.Errorf("signer: the %s keyring needs a passphrase, set $%s or passphrase-file",
+				cfg.KeyringBackend, cfg.PassphraseEnv)
+		}
+		return openKeyring(appName, cfg, os.Stdin)
+	}
+
+	var kr keyring.Keyring
+	err = withoutStdin(func() (err error) {
+		// new keyrings ask for the passphrase twice to confirm it
+		kr, err = openKeyring(appName, cfg, strings.NewReader(pass+"\n"+pass+"\n"))
+		return err
+	})
+	return kr, err
+}
+
+func openKeyring(appName string, cfg config.KeyringConfig, in io.Reader) (keyring.Keyring, error) {
+	kr, err := keyring.New(appName, cfg.KeyringBackend, cfg.KeyringPath, in)
+	if err != nil {
+		return nil, err
+	}
+	err = unlock(kr, cfg.KeyringBackend)
+	if err != nil {
+		return nil, fmt.Errorf("signer: unlocking %s keyring: %w", cfg.KeyringBackend, err)
+	}
+	return kr, nil
+}
+
+// unlockKeyName is the name of the key saved to unlock empty keyrings
+const unlockKeyName = "dalc-unlock"
+
+// unlock makes the keyring read its passphrase, which it does when the first
+// key is decrypted or saved. A key is saved and deleted if the file and os
+// keyrings hold none, which also sets the passphrase of new keyrings.
+func unlock(kr keyring.Keyring, backend string) error {
+	keys, err := kr.List()
+	if err != nil || len(keys) > 0 || (backend != keyring.BackendFile && backend != keyring.BackendOS) {
+		return err
+	}
+	_, err = kr.SavePubKey(unlockKeyName, secp256k1.GenPrivKey().PubKey(), hd.Secp256k1Type)
+	if err != nil {
+		return err
+	}
+	return kr.Delete(unlockKeyName)
+}
+
+// stdinMtx serializes the callers of withoutStdin, as os.Stdin is shared by
+// the whole process
+var stdinMtx sync.Mutex
+
+// withoutStdin calls fn with stdin replaced by the null device. The keyring
+// reads the passphrase from the terminal instead of its input when stdin is
+// one, as input.GetPassword checks os.Stdin, and keyring.Option can not replace
+// its password func. Passing the passphrase as input therefore requires
+// detaching stdin while the keyring is unlocked.
+func withoutStdin(fn func() error) error {
+	null, err := os.Open(os.DevNull)
+	if err != nil {
+		return err
+	}
+	defer null.Close()
+
+	stdinMtx.Lock()
+	defer stdinMtx.Unlock()
+	stdin := os.Stdin
+	os.Stdin = null
+	defer func() { os.Stdin = stdin }()
+	return fn()
+}
+
+// Passphrase returns the keyring passphrase held by the configured environment
+// variable or passphrase file, or "" if none is set
+func Passphrase(cfg config.KeyringConfig) (string, error) {
+	if cfg.PassphraseEnv != "" {
+		if pass := os.Getenv(cfg.PassphraseEnv); pass != "" {
+			return pass, nil
+		}
+	}
+	if cfg.PassphraseFile == "" {
+		return "", nil
+	}
+
+	info, err := os.Stat(cfg.PassphraseFile)
+	if err != nil {
+		return "", err
+	}
+	if perm := info.Mode().Perm(); perm&0o077 != 0 {
+		return "", fmt.Errorf("signer: passphrase file %s must not be accessible by group or others, its mode is %#o",
+			cfg.PassphraseFile, perm)
+	}
+	raw, err := os.ReadFile(cfg.PassphraseFile)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(raw), "\r\n"), nil
+}
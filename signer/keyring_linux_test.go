@@ -0,0 +1,73 @@
+package signer
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+
+	"github.com/celestiaorg/dalc/config"
+)
+
+// openTerminal opens a pseudo terminal and returns its slave end
+func openTerminal(t *testing.T) *os.File {
+	t.Helper()
+	ptmx, err := os.OpenFile("/dev/ptmx", os.O_RDWR, 0)
+	if err != nil {
+		t.Skipf("opening a pseudo terminal: %v", err)
+	}
+	t.Cleanup(func() { ptmx.Close() })
+	require.NoError(t, unix.IoctlSetPointerInt(int(ptmx.Fd()), unix.TIOCSPTLCK, 0))
+	n, err := unix.IoctlGetInt(int(ptmx.Fd()), unix.TIOCGPTN)
+	require.NoError(t, err)
+	tty, err := os.OpenFile(fmt.Sprintf("/dev/pts/%d", n), os.O_RDWR|unix.O_NOCTTY, 0)
+	require.NoError(t, err)
+	t.Cleanup(func() { tty.Close() })
+	return tty
+}
+
+func TestOpenKeyringTerminal(t *testing.T) {
+	stdin, tty := os.Stdin, openTerminal(t)
+	os.Stdin = tty
+	t.Cleanup(func() { os.Stdin = stdin })
+
+	t.Setenv("DALC_TEST_KEYRING_PASSPHRASE", "correct horse")
+
+	// the passphrase is not asked for on the terminal when it is set, even
+	// when keyrings are opened concurrently
+	opened := make(chan error, 4)
+	for i := 0; i < cap(opened); i++ {
+		cfg := config.KeyringConfig{
+			KeyringBackend: keyring.BackendFile,
+			KeyringPath:    t.TempDir(),
+			PassphraseEnv:  "DALC_TEST_KEYRING_PASSPHRASE",
+		}
+		go func() {
+			kr, err := OpenKeyring("dalc", cfg)
+			if err == nil {
+				_, _, err = kr.NewMnemonic("dalc", keyring.English, "", "", hd.Secp256k1)
+			}
+			if err == nil {
+				_, err = OpenKeyring("dalc", cfg)
+			}
+			opened <- err
+		}()
+	}
+	timeout := time.After(time.Second * 10)
+	for i := 0; i < cap(opened); i++ {
+		select {
+		case err := <-opened:
+			require.NoError(t, err)
+		case <-timeout:
+			t.Fatal("the keyring asked for the passphrase on the terminal")
+		}
+	}
+	// stdin is only detached while the keyrings are unlocked
+	assert.Equal(t, tty, os.Stdin)
+}
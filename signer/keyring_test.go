@@ -0,0 +1,77 @@
+package signer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/mattn/go-isatty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/dalc/config"
+)
+
+func TestPassphrase(t *testing.T) {
+	dir := t.TempDir()
+	cfg := config.KeyringConfig{
+		PassphraseEnv:  "DALC_TEST_KEYRING_PASSPHRASE",
+		PassphraseFile: filepath.Join(dir, "passphrase"),
+	}
+
+	require.NoError(t, os.WriteFile(cfg.PassphraseFile, []byte("from file\n"), 0600))
+	pass, err := Passphrase(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, "from file", pass)
+
+	// the environment takes precedence over the file
+	t.Setenv(cfg.PassphraseEnv, "from env")
+	pass, err = Passphrase(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, "from env", pass)
+
+	t.Setenv(cfg.PassphraseEnv, "")
+	require.NoError(t, os.Chmod(cfg.PassphraseFile, 0640))
+	_, err = Passphrase(cfg)
+	assert.Error(t, err)
+
+	pass, err = Passphrase(config.KeyringConfig{PassphraseEnv: cfg.PassphraseEnv})
+	require.NoError(t, err)
+	assert.Empty(t, pass)
+}
+
+func TestOpenKeyring(t *testing.T) {
+	cfg := config.KeyringConfig{
+		KeyringBackend: keyring.BackendFile,
+		KeyringPath:    t.TempDir(),
+		PassphraseEnv:  "DALC_TEST_KEYRING_PASSPHRASE",
+	}
+
+	// the passphrase is only asked for on terminals
+	if !isatty.IsTerminal(os.Stdin.Fd()) {
+		_, err := OpenKeyring("dalc", cfg)
+		assert.Error(t, err, "file keyrings need a passphrase")
+	}
+
+	// the passphrase is set when the keyring is created
+	t.Setenv(cfg.PassphraseEnv, "correct horse")
+	kr, err := OpenKeyring("dalc", cfg)
+	require.NoError(t, err)
+	_, _, err = kr.NewMnemonic("dalc", keyring.English, "", "", hd.Secp256k1)
+	require.NoError(t, err)
+
+	kr, err = OpenKeyring("dalc", cfg)
+	require.NoError(t, err)
+	_, err = kr.Key("dalc")
+	assert.NoError(t, err)
+
+	t.Setenv(cfg.PassphraseEnv, "wrong horse")
+	_, err = OpenKeyring("dalc", cfg)
+	assert.Error(t, err)
+
+	// test keyrings do not need a passphrase
+	_, err = OpenKeyring("dalc", config.KeyringConfig{KeyringBackend: keyring.BackendTest, KeyringPath: t.TempDir()})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,173 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	keysEndpoint = "/keys"
+	signEndpoint = "/sign"
+)
+
+// keyResponse is the response of the keys endpoint
+type keyResponse struct {
+	Name string `json:"name"`
+	// PubKey is the public key encoded as a protobuf Any in JSON
+	PubKey json.RawMessage `json:"pub_key"`
+}
+
+// signRequest is the request of the sign endpoint
+type signRequest struct {
+	Address []byte `json:"address"`
+	Msg     []byte `json:"msg"`
+}
+
+// signResponse is the response of the sign endpoint
+type signResponse struct {
+	Signature []byte          `json:"signature"`
+	PubKey    json.RawMessage `json:"pub_key"`
+}
+
+// errorResponse is returned by the signing daemon when a request fails
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// Remote signs with the keys held by a remote signing daemon, which serves the
+// protocol implemented by NewHandler over HTTP. The public information of the
+// keys is cached once fetched, as the celestia-app signer fetches it again
+// while building transactions and panics if that fails.
+type Remote struct {
+	addr   string
+	token  string
+	client *http.Client
+
+	// keysMtx guards keys, the keys fetched by name
+	keysMtx sync.Mutex
+	keys    map[string]keyring.Info
+}
+
+var _ Signer = (*Remote)(nil)
+
+// NewRemote returns a Signer using the signing daemon at addr, which is reached
+// over https unless it has a scheme. Requests are authenticated with token as a
+// bearer token if it is not empty, and time out after timeout.
+func NewRemote(addr, token string, timeout time.Duration) *Remote {
+	if !strings.Contains(addr, "://") {
+		addr = "https://" + addr
+	}
+	return &Remote{
+		addr:   strings.TrimSuffix(addr, "/"),
+		token:  token,
+		client: &http.Client{Timeout: timeout},
+		keys:   make(map[string]keyring.Info),
+	}
+}
+
+// Key returns the public information of the key named uid
+func (r *Remote) Key(uid string) (keyring.Info, error) {
+	r.keysMtx.Lock()
+	key, ok := r.keys[uid]
+	r.keysMtx.Unlock()
+	if ok {
+		return key, nil
+	}
+
+	var resp keyResponse
+	err := r.do(http.MethodGet, keysEndpoint+"/"+url.PathEscape(uid), nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := unmarshalPubKey(resp.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	key = info{name: resp.Name, pubKey: pubKey}
+	r.keysMtx.Lock()
+	r.keys[uid] = key
+	r.keysMtx.Unlock()
+	return key, nil
+}
+
+// SignByAddress signs msg with the key of address. The signature is rejected if
+// the daemon signed with another key.
+func (r *Remote) SignByAddress(address sdk.Address, msg []byte) ([]byte, cryptotypes.PubKey, error) {
+	var resp signResponse
+	err := r.do(http.MethodPost, signEndpoint, signRequest{Address: address.Bytes(), Msg: msg}, &resp)
+	if err != nil {
+		return nil, nil, err
+	}
+	pubKey, err := unmarshalPubKey(resp.PubKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !bytes.Equal(pubKey.Address(), address.Bytes()) {
+		return nil, nil, fmt.Errorf("signer: signed for %s with the key of %s", address, sdk.AccAddress(pubKey.Address()))
+	}
+	if !pubKey.VerifySignature(msg, resp.Signature) {
+		return nil, nil, fmt.Errorf("signer: invalid signature returned for %s", address)
+	}
+	return resp.Signature, pubKey, nil
+}
+
+func (r *Remote) do(method, endpoint string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		raw, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(raw)
+	}
+
+	// the keyring interface does not carry contexts, requests are bounded by
+	// the timeout of the client
+	req, err := http.NewRequestWithContext(context.Background(), method, r.addr+endpoint, body)
+	if err != nil {
+		return err
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if r.token != "" {
+		req.Header.Set("Authorization", "Bearer "+r.token)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp errorResponse
+		raw, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		if json.Unmarshal(raw, &errResp) != nil || errResp.Error == "" {
+			errResp.Error = strings.TrimSpace(string(raw))
+		}
+		return fmt.Errorf("signer %s: %s: %s", endpoint, resp.Status, errResp.Error)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+func unmarshalPubKey(raw json.RawMessage) (cryptotypes.PubKey, error) {
+	var pubKey cryptotypes.PubKey
+	err := cdc.UnmarshalInterfaceJSON(raw, &pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("signer: decoding public key: %w", err)
+	}
+	return pubKey, nil
+}
@@ -0,0 +1,137 @@
+// Package signer abstracts the keys signing the transactions of the dalc, so
+// that they can be held by a local keyring or by a remote signing daemon.
+package signer
+
+import (
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ErrUnsupported is returned by the keyring operations that a Signer does not
+// support, such as managing keys
+var ErrUnsupported = errors.New("signer: operation not supported by the signer")
+
+// Signer holds the keys signing transactions. A keyring.Keyring is a Signer.
+type Signer interface {
+	// Key returns the public information of the key named uid
+	Key(uid string) (keyring.Info, error)
+	// SignByAddress signs msg with the key of address and returns the
+	// signature and the public key of address
+	SignByAddress(address sdk.Address, msg []byte) ([]byte, cryptotypes.PubKey, error)
+}
+
+// Keyring returns a keyring.Keyring signing with s, which can be used where
+// the cosmos-sdk expects a keyring. Keyrings are returned as is, the keyring
+// operations other than those of Signer fail with ErrUnsupported otherwise.
+func Keyring(s Signer) keyring.Keyring {
+	if kr, ok := s.(keyring.Keyring); ok {
+		return kr
+	}
+	return signerKeyring{s}
+}
+
+// cdc encodes the public keys exchanged with remote signers
+var cdc = func() *codec.ProtoCodec {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry)
+}()
+
+// info describes a key whose private key is held by a remote signer
+type info struct {
+	name   string
+	pubKey cryptotypes.PubKey
+}
+
+var _ keyring.Info = info{}
+
+func (i info) GetType() keyring.KeyType          { return keyring.TypeOffline }
+func (i info) GetName() string                   { return i.name }
+func (i info) GetPubKey() cryptotypes.PubKey     { return i.pubKey }
+func (i info) GetAddress() sdk.AccAddress        { return i.pubKey.Address().Bytes() }
+func (i info) GetPath() (*hd.BIP44Params, error) { return nil, ErrUnsupported }
+func (i info) GetAlgo() hd.PubKeyType            { return hd.PubKeyType(i.pubKey.Type()) }
+
+// signerKeyring adapts a Signer to keyring.Keyring
+type signerKeyring struct {
+	Signer
+}
+
+func (k signerKeyring) Sign(uid string, msg []byte) ([]byte, cryptotypes.PubKey, error) {
+	key, err := k.Key(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+	return k.SignByAddress(key.GetAddress(), msg)
+}
+
+func (k signerKeyring) List() ([]keyring.Info, error) {
+	return nil, ErrUnsupported
+}
+
+func (k signerKeyring) SupportedAlgorithms() (keyring.SigningAlgoList, keyring.SigningAlgoList) {
+	return nil, nil
+}
+
+func (k signerKeyring) KeyByAddress(address sdk.Address) (keyring.Info, error) {
+	return nil, ErrUnsupported
+}
+
+func (k signerKeyring) Delete(uid string) error {
+	return ErrUnsupported
+}
+
+func (k signerKeyring) DeleteByAddress(address sdk.Address) error {
+	return ErrUnsupported
+}
+
+func (k signerKeyring) NewMnemonic(string, keyring.Language, string, string, keyring.SignatureAlgo) (keyring.Info, string, error) {
+	return nil, "", ErrUnsupported
+}
+
+func (k signerKeyring) NewAccount(string, string, string, string, keyring.SignatureAlgo) (keyring.Info, error) {
+	return nil, ErrUnsupported
+}
+
+func (k signerKeyring) SaveLedgerKey(string, keyring.SignatureAlgo, string, uint32, uint32, uint32) (keyring.Info, error) {
+	return nil, ErrUnsupported
+}
+
+func (k signerKeyring) SavePubKey(string, cryptotypes.PubKey, hd.PubKeyType) (keyring.Info, error) {
+	return nil, ErrUnsupported
+}
+
+func (k signerKeyring) SaveMultisig(string, cryptotypes.PubKey) (keyring.Info, error) {
+	return nil, ErrUnsupported
+}
+
+func (k signerKeyring) ImportPrivKey(uid, armor, passphrase string) error {
+	return ErrUnsupported
+}
+
+func (k signerKeyring) ImportPubKey(uid string, armor string) error {
+	return ErrUnsupported
+}
+
+func (k signerKeyring) ExportPubKeyArmor(uid string) (string, error) {
+	return "", ErrUnsupported
+}
+
+func (k signerKeyring) ExportPubKeyArmorByAddress(address sdk.Address) (string, error) {
+	return "", ErrUnsupported
+}
+
+func (k signerKeyring) ExportPrivKeyArmor(uid, encryptPassphrase string) (string, error) {
+	return "", ErrUnsupported
+}
+
+func (k signerKeyring) ExportPrivKeyArmorByAddress(address sdk.Address, encryptPassphrase string) (string, error) {
+	return "", ErrUnsupported
+}
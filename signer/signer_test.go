@@ -0,0 +1,98 @@
+package signer
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemote(t *testing.T) {
+	kr := keyring.NewInMemory()
+	local, _, err := kr.NewMnemonic("dalc", keyring.English, "", "", hd.Secp256k1)
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(NewHandler(kr, "secret"))
+	t.Cleanup(srv.Close)
+	remote := NewRemote(srv.URL, "secret", time.Second)
+
+	key, err := remote.Key("dalc")
+	require.NoError(t, err)
+	assert.Equal(t, "dalc", key.GetName())
+	assert.Equal(t, local.GetAddress(), key.GetAddress())
+	assert.True(t, local.GetPubKey().Equals(key.GetPubKey()))
+	assert.Equal(t, hd.Secp256k1Type, key.GetAlgo())
+
+	// secp256k1 signatures are deterministic
+	msg := []byte("sign bytes")
+	sig, pub, err := remote.SignByAddress(key.GetAddress(), msg)
+	require.NoError(t, err)
+	localSig, _, err := kr.SignByAddress(local.GetAddress(), msg)
+	require.NoError(t, err)
+	assert.Equal(t, localSig, sig)
+	assert.True(t, pub.VerifySignature(msg, sig))
+
+	_, err = remote.Key("unknown")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "404")
+
+	_, err = NewRemote(srv.URL, "wrong", time.Second).Key("dalc")
+	assert.Error(t, err)
+
+	// keys are cached once fetched while signing needs the daemon
+	srv.Close()
+	cached, err := remote.Key("dalc")
+	require.NoError(t, err)
+	assert.Equal(t, key, cached)
+	_, _, err = remote.SignByAddress(key.GetAddress(), msg)
+	assert.Error(t, err)
+}
+
+func TestRemoteWrongKey(t *testing.T) {
+	kr := keyring.NewInMemory()
+	requested, _, err := kr.NewMnemonic("dalc", keyring.English, "", "", hd.Secp256k1)
+	require.NoError(t, err)
+	_, _, err = kr.NewMnemonic("other", keyring.English, "", "", hd.Secp256k1)
+	require.NoError(t, err)
+
+	// the daemon signs every message with the other key
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req signRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		sig, pub, err := kr.Sign("other", req.Msg)
+		assert.NoError(t, err)
+		pubKey, err := cdc.MarshalInterfaceJSON(pub)
+		assert.NoError(t, err)
+		writeJSON(w, signResponse{Signature: sig, PubKey: pubKey})
+	}))
+	t.Cleanup(srv.Close)
+
+	_, _, err = NewRemote(srv.URL, "", time.Second).SignByAddress(requested.GetAddress(), []byte("sign bytes"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), requested.GetAddress().String())
+}
+
+func TestKeyring(t *testing.T) {
+	kr := keyring.NewInMemory()
+	assert.Equal(t, kr, Keyring(kr))
+
+	_, _, err := kr.NewMnemonic("dalc", keyring.English, "", "", hd.Secp256k1)
+	require.NoError(t, err)
+	srv := httptest.NewServer(NewHandler(kr, ""))
+	t.Cleanup(srv.Close)
+
+	ring := Keyring(NewRemote(srv.URL, "", time.Second))
+	msg := []byte("sign bytes")
+	sig, pub, err := ring.Sign("dalc", msg)
+	require.NoError(t, err)
+	assert.True(t, pub.VerifySignature(msg, sig))
+
+	_, err = ring.List()
+	assert.ErrorIs(t, err, ErrUnsupported)
+}
@@ -0,0 +1,29 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/dalc/config"
+)
+
+func TestSetup(t *testing.T) {
+	ctx := context.Background()
+
+	shutdown, err := Setup(ctx, config.DefaultTracingConfig())
+	require.NoError(t, err)
+	assert.NoError(t, shutdown(ctx))
+
+	cfg := config.DefaultTracingConfig()
+	cfg.Exporter = "zipkin"
+	_, err = Setup(ctx, cfg)
+	assert.Error(t, err)
+
+	cfg.Exporter = ExporterStdout
+	shutdown, err = Setup(ctx, cfg)
+	require.NoError(t, err)
+	assert.NoError(t, shutdown(ctx))
+}